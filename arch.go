@@ -0,0 +1,132 @@
+package vex_go
+
+// ABI 描述一种调用约定使用的参数与返回值寄存器，均为寄存器表中的规范名称
+type ABI struct {
+	Name string
+	Args []string // 按顺序传递参数的寄存器，全部通过栈传参时为空
+	Rets []string // 返回值寄存器
+}
+
+// ArchDescriptor 描述客户机架构的基本属性
+type ArchDescriptor struct {
+	Arch           VexArch
+	Name           string
+	WordSize       int        // 字长，单位为字节
+	DefaultEndness VexEndness // 默认字节序
+	InstAlignment  int        // 指令的最小对齐（ARM 的 Thumb、RISCV64 的压缩指令为 2）
+	SP             int32      // 栈指针偏移
+	IP             int32      // 指令指针偏移
+	LR             int32      // 链接寄存器偏移，没有时为 -1
+	BP             int32      // 帧指针偏移，没有时为 -1
+	ABIs           []ABI      // 第一项为默认调用约定
+	Layout         *GuestLayout
+}
+
+// DefaultABI 返回架构的默认调用约定
+func (d *ArchDescriptor) DefaultABI() ABI {
+	return d.ABIs[0]
+}
+
+// ABI 根据名称查找调用约定
+func (d *ArchDescriptor) ABI(name string) (ABI, bool) {
+	for _, abi := range d.ABIs {
+		if abi.Name == name {
+			return abi, true
+		}
+	}
+	return ABI{}, false
+}
+
+// archSpec 以寄存器名描述架构，在 init 中解析为 ArchDescriptor
+type archSpec struct {
+	arch           VexArch
+	name           string
+	wordSize       int
+	endness        VexEndness
+	align          int
+	sp, ip, lr, bp string
+	abis           []ABI
+}
+
+var archSpecs = []archSpec{
+	{VexArchX86, "x86", 4, VexEndnessLE, 1, "esp", "eip", "", "ebp", []ABI{
+		{"cdecl", nil, []string{"eax", "edx"}},
+	}},
+	{VexArchAMD64, "amd64", 8, VexEndnessLE, 1, "rsp", "rip", "", "rbp", []ABI{
+		{"sysv", []string{"rdi", "rsi", "rdx", "rcx", "r8", "r9"}, []string{"rax", "rdx"}},
+		{"ms", []string{"rcx", "rdx", "r8", "r9"}, []string{"rax"}},
+	}},
+	{VexArchARM, "arm", 4, VexEndnessLE, 2, "r13", "r15", "r14", "r11", []ABI{
+		{"aapcs", []string{"r0", "r1", "r2", "r3"}, []string{"r0", "r1"}},
+	}},
+	{VexArchARM64, "arm64", 8, VexEndnessLE, 4, "sp", "pc", "x30", "x29", []ABI{
+		{"aapcs64", []string{"x0", "x1", "x2", "x3", "x4", "x5", "x6", "x7"}, []string{"x0", "x1"}},
+	}},
+	{VexArchPPC32, "ppc32", 4, VexEndnessBE, 4, "r1", "cia", "lr", "r31", []ABI{
+		{"sysv", []string{"r3", "r4", "r5", "r6", "r7", "r8", "r9", "r10"}, []string{"r3", "r4"}},
+	}},
+	{VexArchPPC64, "ppc64", 8, VexEndnessBE, 4, "r1", "cia", "lr", "r31", []ABI{
+		{"elfv1", []string{"r3", "r4", "r5", "r6", "r7", "r8", "r9", "r10"}, []string{"r3", "r4"}},
+		{"elfv2", []string{"r3", "r4", "r5", "r6", "r7", "r8", "r9", "r10"}, []string{"r3", "r4"}},
+	}},
+	{VexArchS390X, "s390x", 8, VexEndnessBE, 2, "r15", "ia", "r14", "r11", []ABI{
+		{"elf", []string{"r2", "r3", "r4", "r5", "r6"}, []string{"r2"}},
+	}},
+	{VexArchMIPS32, "mips32", 4, VexEndnessBE, 4, "r29", "pc", "r31", "r30", []ABI{
+		{"o32", []string{"r4", "r5", "r6", "r7"}, []string{"r2", "r3"}},
+	}},
+	{VexArchMIPS64, "mips64", 8, VexEndnessBE, 4, "r29", "pc", "r31", "r30", []ABI{
+		{"n64", []string{"r4", "r5", "r6", "r7", "r8", "r9", "r10", "r11"}, []string{"r2", "r3"}},
+	}},
+	{VexArchTILEGX, "tilegx", 8, VexEndnessLE, 8, "r54", "pc", "r55", "r52", []ABI{
+		{"tilegx", []string{"r0", "r1", "r2", "r3", "r4", "r5", "r6", "r7", "r8", "r9"}, []string{"r0"}},
+	}},
+	{VexArchRISCV64, "riscv64", 8, VexEndnessLE, 2, "x2", "pc", "x1", "x8", []ABI{
+		{"lp64", []string{"x10", "x11", "x12", "x13", "x14", "x15", "x16", "x17"}, []string{"x10", "x11"}},
+	}},
+}
+
+var archDescriptors = map[VexArch]*ArchDescriptor{}
+
+func init() {
+	for _, s := range archSpecs {
+		archDescriptors[s.arch] = &ArchDescriptor{
+			Arch:           s.arch,
+			Name:           s.name,
+			WordSize:       s.wordSize,
+			DefaultEndness: s.endness,
+			InstAlignment:  s.align,
+			SP:             mustRegisterOffset(s.arch, s.sp),
+			IP:             mustRegisterOffset(s.arch, s.ip),
+			LR:             mustRegisterOffset(s.arch, s.lr),
+			BP:             mustRegisterOffset(s.arch, s.bp),
+			ABIs:           s.abis,
+			Layout:         GetGuestLayout(s.arch),
+		}
+		for _, abi := range s.abis {
+			for _, r := range abi.Args {
+				mustRegisterOffset(s.arch, r)
+			}
+			for _, r := range abi.Rets {
+				mustRegisterOffset(s.arch, r)
+			}
+		}
+	}
+}
+
+// mustRegisterOffset 返回寄存器偏移，名称为空时返回 -1
+func mustRegisterOffset(arch VexArch, name string) int32 {
+	if name == "" {
+		return -1
+	}
+	off, ok := RegisterOffset(arch, name)
+	if !ok {
+		panic("unknown register " + name)
+	}
+	return off
+}
+
+// GetArchDescriptor 返回架构描述，未知架构返回 nil
+func GetArchDescriptor(arch VexArch) *ArchDescriptor {
+	return archDescriptors[arch]
+}
@@ -0,0 +1,25 @@
+package vex_go
+
+import "testing"
+
+func TestArchDescriptor(t *testing.T) {
+	d := GetArchDescriptor(VexArchAMD64)
+	if d.WordSize != 8 || d.SP != 48 || d.IP != 184 || d.LR != -1 || d.BP != 56 {
+		t.Fatalf("unexpected AMD64 descriptor %+v", d)
+	}
+	if abi := d.DefaultABI(); abi.Name != "sysv" || abi.Args[0] != "rdi" {
+		t.Fatalf("unexpected default ABI %+v", abi)
+	}
+	if _, ok := d.ABI("ms"); !ok {
+		t.Fatal("ms ABI missing")
+	}
+	d = GetArchDescriptor(VexArchARM64)
+	if d.SP != int32(ARM64RegisterOffsets["sp"]) || d.LR != int32(ARM64RegisterOffsets["lr"]) || d.Layout == nil {
+		t.Fatalf("unexpected ARM64 descriptor %+v", d)
+	}
+	for arch := range registerTables {
+		if d := GetArchDescriptor(arch); d == nil || d.Arch != arch || d.Layout != GetGuestLayout(arch) {
+			t.Errorf("descriptor for %v missing", arch)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package vex_go
+
+/*
+#cgo CFLAGS: -I${SRCDIR}/vex/pub -I${SRCDIR}/pyvex_c
+#cgo LDFLAGS: -lpyvex
+#include <libvex.h>
+#include "pyvex.h"
+*/
+import "C"
+
+// x86：基线为 Pentium（FPU、MMX，无 SSE），MMXEXT 是早期 AMD 处理器上 SSE1 的整数子集。
+// 扩展必须按 MMXEXT < SSE1 < SSE2 < SSE3 依次包含，LZCNT 需要 SSE2
+const (
+	VexHwcapsX86MMXEXT uint32 = C.VEX_HWCAPS_X86_MMXEXT
+	VexHwcapsX86SSE1   uint32 = C.VEX_HWCAPS_X86_SSE1
+	VexHwcapsX86SSE2   uint32 = C.VEX_HWCAPS_X86_SSE2
+	VexHwcapsX86SSE3   uint32 = C.VEX_HWCAPS_X86_SSE3
+	VexHwcapsX86LZCNT  uint32 = C.VEX_HWCAPS_X86_LZCNT
+)
+
+// amd64：基线为 SSE2（有 cmpxchg8b，无 cmpxchg16b）。AVX 需要 SSE3，AVX2 与 BMI 需要 AVX。
+// CPUID 指令模拟的处理器型号同样由这些位决定
+const (
+	VexHwcapsAMD64SSE3   uint32 = C.VEX_HWCAPS_AMD64_SSE3
+	VexHwcapsAMD64CX16   uint32 = C.VEX_HWCAPS_AMD64_CX16
+	VexHwcapsAMD64LZCNT  uint32 = C.VEX_HWCAPS_AMD64_LZCNT
+	VexHwcapsAMD64AVX    uint32 = C.VEX_HWCAPS_AMD64_AVX
+	VexHwcapsAMD64RDTSCP uint32 = C.VEX_HWCAPS_AMD64_RDTSCP
+	VexHwcapsAMD64BMI    uint32 = C.VEX_HWCAPS_AMD64_BMI
+	VexHwcapsAMD64AVX2   uint32 = C.VEX_HWCAPS_AMD64_AVX2
+)
+
+// ppc32：基线为整数指令，其余扩展需要 F
+const (
+	VexHwcapsPPC32F      uint32 = C.VEX_HWCAPS_PPC32_F
+	VexHwcapsPPC32V      uint32 = C.VEX_HWCAPS_PPC32_V
+	VexHwcapsPPC32FX     uint32 = C.VEX_HWCAPS_PPC32_FX
+	VexHwcapsPPC32GX     uint32 = C.VEX_HWCAPS_PPC32_GX
+	VexHwcapsPPC32VX     uint32 = C.VEX_HWCAPS_PPC32_VX
+	VexHwcapsPPC32DFP    uint32 = C.VEX_HWCAPS_PPC32_DFP
+	VexHwcapsPPC32ISA207 uint32 = C.VEX_HWCAPS_PPC32_ISA2_07
+	VexHwcapsPPC32ISA30  uint32 = C.VEX_HWCAPS_PPC32_ISA3_0
+)
+
+// ppc64：基线包含浮点
+const (
+	VexHwcapsPPC64V      uint32 = C.VEX_HWCAPS_PPC64_V
+	VexHwcapsPPC64FX     uint32 = C.VEX_HWCAPS_PPC64_FX
+	VexHwcapsPPC64GX     uint32 = C.VEX_HWCAPS_PPC64_GX
+	VexHwcapsPPC64VX     uint32 = C.VEX_HWCAPS_PPC64_VX
+	VexHwcapsPPC64DFP    uint32 = C.VEX_HWCAPS_PPC64_DFP
+	VexHwcapsPPC64ISA207 uint32 = C.VEX_HWCAPS_PPC64_ISA2_07
+	VexHwcapsPPC64ISA30  uint32 = C.VEX_HWCAPS_PPC64_ISA3_0
+)
+
+// s390x：低 6 位为处理器型号（VexS390XModel*），其余为各个 facility
+const (
+	VexHwcapsS390XLDISP uint32 = C.VEX_HWCAPS_S390X_LDISP
+	VexHwcapsS390XEIMM  uint32 = C.VEX_HWCAPS_S390X_EIMM
+	VexHwcapsS390XGIE   uint32 = C.VEX_HWCAPS_S390X_GIE
+	VexHwcapsS390XDFP   uint32 = C.VEX_HWCAPS_S390X_DFP
+	VexHwcapsS390XFGX   uint32 = C.VEX_HWCAPS_S390X_FGX
+	VexHwcapsS390XETF2  uint32 = C.VEX_HWCAPS_S390X_ETF2
+	VexHwcapsS390XSTFLE uint32 = C.VEX_HWCAPS_S390X_STFLE
+	VexHwcapsS390XETF3  uint32 = C.VEX_HWCAPS_S390X_ETF3
+	VexHwcapsS390XSTCKF uint32 = C.VEX_HWCAPS_S390X_STCKF
+	VexHwcapsS390XFPEXT uint32 = C.VEX_HWCAPS_S390X_FPEXT
+	VexHwcapsS390XLSC   uint32 = C.VEX_HWCAPS_S390X_LSC
+	VexHwcapsS390XPFPO  uint32 = C.VEX_HWCAPS_S390X_PFPO
+	VexHwcapsS390XVX    uint32 = C.VEX_HWCAPS_S390X_VX
+	VexHwcapsS390XMSA5  uint32 = C.VEX_HWCAPS_S390X_MSA5
+	VexHwcapsS390XMI2   uint32 = C.VEX_HWCAPS_S390X_MI2
+	VexHwcapsS390XAll   uint32 = C.VEX_HWCAPS_S390X_ALL
+)
+
+const (
+	VexS390XModelZ900    uint32 = C.VEX_S390X_MODEL_Z900
+	VexS390XModelZ800    uint32 = C.VEX_S390X_MODEL_Z800
+	VexS390XModelZ990    uint32 = C.VEX_S390X_MODEL_Z990
+	VexS390XModelZ890    uint32 = C.VEX_S390X_MODEL_Z890
+	VexS390XModelZ9EC    uint32 = C.VEX_S390X_MODEL_Z9_EC
+	VexS390XModelZ9BC    uint32 = C.VEX_S390X_MODEL_Z9_BC
+	VexS390XModelZ10EC   uint32 = C.VEX_S390X_MODEL_Z10_EC
+	VexS390XModelZ10BC   uint32 = C.VEX_S390X_MODEL_Z10_BC
+	VexS390XModelZ196    uint32 = C.VEX_S390X_MODEL_Z196
+	VexS390XModelZ114    uint32 = C.VEX_S390X_MODEL_Z114
+	VexS390XModelZEC12   uint32 = C.VEX_S390X_MODEL_ZEC12
+	VexS390XModelZBC12   uint32 = C.VEX_S390X_MODEL_ZBC12
+	VexS390XModelZ13     uint32 = C.VEX_S390X_MODEL_Z13
+	VexS390XModelZ13S    uint32 = C.VEX_S390X_MODEL_Z13S
+	VexS390XModelUnknown uint32 = C.VEX_S390X_MODEL_UNKNOWN
+	VexS390XModelMask    uint32 = C.VEX_S390X_MODEL_MASK
+)
+
+// arm：低 6 位为架构版本（如 7 表示 ARMv7），其余为扩展
+const (
+	VexHwcapsARMArchLevelMask uint32 = 0x3f
+	VexHwcapsARMVFP           uint32 = C.VEX_HWCAPS_ARM_VFP
+	VexHwcapsARMVFP2          uint32 = C.VEX_HWCAPS_ARM_VFP2
+	VexHwcapsARMVFP3          uint32 = C.VEX_HWCAPS_ARM_VFP3
+	VexHwcapsARMNEON          uint32 = C.VEX_HWCAPS_ARM_NEON
+)
+
+// mips：hwcaps 的格式与 CP0 PRId 寄存器相同，位 23:16 为厂商，位 15:8 为处理器，
+// 位 31:24 记录 ISA 版本与浮点模式
+const (
+	VexPRIdCompLegacy    uint32 = C.VEX_PRID_COMP_LEGACY
+	VexPRIdCompMIPS      uint32 = C.VEX_PRID_COMP_MIPS
+	VexPRIdCompBroadcom  uint32 = C.VEX_PRID_COMP_BROADCOM
+	VexPRIdCompNetlogic  uint32 = C.VEX_PRID_COMP_NETLOGIC
+	VexPRIdCompCavium    uint32 = C.VEX_PRID_COMP_CAVIUM
+	VexPRIdCompIngenicE1 uint32 = C.VEX_PRID_COMP_INGENIC_E1
+	VexPRIdImpLoongson64 uint32 = C.VEX_PRID_IMP_LOONGSON_64
+	VexPRIdImp34K        uint32 = C.VEX_PRID_IMP_34K
+	VexPRIdImp74K        uint32 = C.VEX_PRID_IMP_74K
+	VexMIPSCPUISAM32R1   uint32 = C.VEX_MIPS_CPU_ISA_M32R1
+	VexMIPSCPUISAM32R2   uint32 = C.VEX_MIPS_CPU_ISA_M32R2
+	VexMIPSCPUISAM64R1   uint32 = C.VEX_MIPS_CPU_ISA_M64R1
+	VexMIPSCPUISAM64R2   uint32 = C.VEX_MIPS_CPU_ISA_M64R2
+	VexMIPSCPUISAM32R6   uint32 = C.VEX_MIPS_CPU_ISA_M32R6
+	VexMIPSCPUISAM64R6   uint32 = C.VEX_MIPS_CPU_ISA_M64R6
+	VexMIPSHostFR        uint32 = C.VEX_MIPS_HOST_FR
+)
+
+// ArchInfo 对应 VexArchInfo，描述被提升代码所运行的处理器。arm64 与 riscv64 目前没有 hwcaps 位。
+//
+// 解码器遇到 Hwcaps 中未启用的扩展指令时按无法解码处理：位于块首时返回 ErrDecode，位于块中时块以
+// Ijk_NoDecode 结束，并不会得到 Ijk_SigILL（VEX 只在 mips 上使用它）。并非所有扩展指令都受 Hwcaps 控制，
+// 例如 amd64 的 256 位 vpaddd 在没有 AVX2 时仍可解码。
+// 不合理的组合（如 amd64 有 AVX2 无 AVX）会使提升以 ErrVexPanic 失败
+type ArchInfo struct {
+	Hwcaps              uint32
+	Endness             VexEndness // 为 0 或 VexEndnessInvalid 时使用提升时指定的字节序
+	PPCIcacheLineSzB    int32      // ppc：指令缓存行大小，影响 icbi
+	PPCDcbzSzB          uint32     // ppc：dcbz 清零的字节数
+	PPCDcbzlSzB         uint32     // ppc：dcbzl 清零的字节数，0 表示不支持
+	ARM64DMinLineLg2SzB uint32     // arm64：CTR_EL0.DminLine，以 2 为底的对数
+	ARM64IMinLineLg2SzB uint32     // arm64：CTR_EL0.IminLine，以 2 为底的对数
+	X86CR0              uint32     // x86：CR0 的值，最低位 PE 为 0 时按 16 位实模式解码
+}
+
+// DefaultArchInfo 返回未设置 LiftOptions.ArchInfo 时使用的处理器描述，不支持的架构返回 nil
+func DefaultArchInfo(arch VexArch) *ArchInfo {
+	if !isSupportedArch(arch) {
+		return nil
+	}
+	var vai C.VexArchInfo
+	C.vex_default_archinfo(C.VexArch(arch), &vai)
+	return &ArchInfo{
+		Hwcaps:              uint32(vai.hwcaps),
+		Endness:             VexEndness(vai.endness),
+		PPCIcacheLineSzB:    int32(vai.ppc_icache_line_szB),
+		PPCDcbzSzB:          uint32(vai.ppc_dcbz_szB),
+		PPCDcbzlSzB:         uint32(vai.ppc_dcbzl_szB),
+		ARM64DMinLineLg2SzB: uint32(vai.arm64_dMinLine_lg2_szB),
+		ARM64IMinLineLg2SzB: uint32(vai.arm64_iMinLine_lg2_szB),
+		X86CR0:              uint32(vai.x86_cr0),
+	}
+}
+
+// toC 转换为 VexArchInfo，en 为提升时指定的字节序
+func (ai *ArchInfo) toC(en VexEndness) C.VexArchInfo {
+	var vai C.VexArchInfo
+	C.LibVEX_default_VexArchInfo(&vai)
+	vai.hwcaps = C.UInt(ai.Hwcaps)
+	vai.endness = C.VexEndness(en)
+	if ai.Endness != 0 && ai.Endness != VexEndnessInvalid {
+		vai.endness = C.VexEndness(ai.Endness)
+	}
+	vai.ppc_icache_line_szB = C.Int(ai.PPCIcacheLineSzB)
+	vai.ppc_dcbz_szB = C.UInt(ai.PPCDcbzSzB)
+	vai.ppc_dcbzl_szB = C.UInt(ai.PPCDcbzlSzB)
+	vai.arm64_dMinLine_lg2_szB = C.UInt(ai.ARM64DMinLineLg2SzB)
+	vai.arm64_iMinLine_lg2_szB = C.UInt(ai.ARM64IMinLineLg2SzB)
+	vai.x86_cr0 = C.UInt(ai.X86CR0)
+	return vai
+}
@@ -0,0 +1,86 @@
+package vex_go
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestArchInfo(t *testing.T) {
+	if DefaultArchInfo(VexArchTILEGX) != nil {
+		t.Fatal("expected nil ArchInfo for unsupported arch")
+	}
+	def := DefaultArchInfo(VexArchAMD64)
+	if def.Hwcaps&VexHwcapsAMD64AVX2 == 0 || def.X86CR0 != 0xffffffff {
+		t.Fatalf("unexpected default ArchInfo %+v", def)
+	}
+	if ai := DefaultArchInfo(VexArchARM64); ai.ARM64DMinLineLg2SzB != 6 || ai.ARM64IMinLineLg2SzB != 6 {
+		t.Fatalf("unexpected arm64 line sizes %+v", ai)
+	}
+
+	// Haswell 之前的处理器没有 ADCX，按无法解码处理
+	adcx := []byte{0x66, 0x48, 0x0f, 0x38, 0xf6, 0xc1, 0xc3} // adcx rax, rcx; ret
+	haswell := *def
+	opts := DefaultLiftOptions()
+	opts.ArchInfo = &haswell
+	l := NewLifter(VexArchAMD64, VexEndnessLE, opts)
+	haswell.Hwcaps &^= VexHwcapsAMD64AVX2 // NewLifter 复制了 ArchInfo
+	if r, err := l.Lift(adcx, 0x1000); err != nil || r.Size != len(adcx) {
+		t.Fatalf("adcx with AVX2: %v", err)
+	}
+	// VEX 在 amd64 上不产生 Ijk_SigILL：位于块首时为 ErrDecode，位于块中时块以 Ijk_NoDecode 结束
+	if _, err := VexLift(VexArchAMD64, adcx, 0x1000, VexEndnessLE, opts); !errors.Is(err, ErrDecode) {
+		t.Fatalf("adcx without AVX2: got %v, want %v", err, ErrDecode)
+	}
+	r, err := NewLifter(VexArchAMD64, VexEndnessLE, opts).Lift(append([]byte{0x90}, adcx...), 0x1000)
+	if err != nil || r.Size != 1 || r.Block.JumpKind != IjkNoDecode {
+		t.Fatalf("nop; adcx without AVX2: %v", err)
+	}
+
+	// CPUID 的模拟函数随 hwcaps 变化
+	cpuid := func(ai *ArchInfo) string {
+		opts := DefaultLiftOptions()
+		opts.ArchInfo = ai
+		r, err := NewLifter(VexArchAMD64, VexEndnessLE, opts).Lift([]byte{0x0f, 0xa2, 0xc3}, 0x1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, s := range r.Block.Stmts {
+			if d, ok := s.(*DirtyStmt); ok {
+				return d.Cee.Name
+			}
+		}
+		t.Fatal("no dirty call for cpuid")
+		return ""
+	}
+	if name := cpuid(nil); name != "amd64g_dirtyhelper_CPUID_avx2" {
+		t.Errorf("default cpuid helper %s", name)
+	}
+	if name := cpuid(&ArchInfo{}); name != "amd64g_dirtyhelper_CPUID_baseline" {
+		t.Errorf("baseline cpuid helper %s", name)
+	}
+
+	// 不合理的组合：AVX2 需要 AVX
+	opts.ArchInfo = &ArchInfo{Hwcaps: VexHwcapsAMD64SSE3 | VexHwcapsAMD64AVX2}
+	if _, err := VexLift(VexArchAMD64, adcx, 0x1000, VexEndnessLE, opts); !errors.Is(err, ErrVexPanic) {
+		t.Fatalf("invalid hwcaps: got %v, want %v", err, ErrVexPanic)
+	}
+
+	// x86 的 CR0.PE 决定默认操作数大小
+	movEax := []byte{0xb8, 0x01, 0x00, 0x40, 0x40, 0xc3} // mov eax, 0x40400001; ret
+	for _, c := range []struct {
+		cr0   uint32
+		insts int
+	}{{0xffffffff, 2}, {0, 4}} { // 实模式下为 mov ax, 1; inc ax; inc ax; ret
+		ai := DefaultArchInfo(VexArchX86)
+		ai.X86CR0 = c.cr0
+		opts := DefaultLiftOptions()
+		opts.ArchInfo = ai
+		r, err := VexLift(VexArchX86, movEax, 0x1000, VexEndnessLE, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if r.Insts() != c.insts {
+			t.Errorf("cr0 %#x: lifted %d instructions, want %d", c.cr0, r.Insts(), c.insts)
+		}
+	}
+}
@@ -0,0 +1,712 @@
+package vex_go
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"math"
+)
+
+// 本文件定义 Block 的紧凑二进制格式，用于在磁盘上缓存大量提升结果。
+//
+// 单个块（MarshalBinary）的布局为 "VXIR"、uvarint 版本号以及块体；流（BlockWriter）
+// 的布局为 "VXIS"、uvarint 版本号，之后每个块为 uvarint 长度加块体。块体依次为：
+//
+//	arch      uvarint
+//	ops       uvarint 个数，每项为 ppIROp 名称，表达式以下标引用
+//	consts    uvarint 个数，每项为 tag 与 uvarint 位模式，Const/Exit 以下标引用
+//	callees   uvarint 个数，每项为名称、regparms 与 mcx_mask，CCall/Dirty 以下标引用
+//	type_env  uvarint 个数，每项一个字节
+//	stmts     uvarint 个数，每条语句以 tag 字节开头
+//	next      表达式
+//	jumpkind  一个字节
+//	offs_ip   varint
+//
+// 枚举只保存低字节（VEX 各枚举均从 0xXX00 开始），偏移使用 zigzag varint，
+// 临时变量保存为 t+1 使 IRTempInvalid 编码为 0，nil 表达式编码为 0。
+// Callee 的宿主机地址随进程变化，不写入缓存，解码后为 0。
+// 解码器无法读回 nil 语句与无效的 IROp，编码时直接返回错误。
+
+// IRBinaryVersion 是二进制格式的版本，格式发生不兼容的变化时递增
+const IRBinaryVersion = 2
+
+const (
+	blockMagic  = "VXIR"
+	streamMagic = "VXIS"
+)
+
+// binEncoder 先写块体，再在前面补上操作码、常量与被调函数表
+type binEncoder struct {
+	body    []byte
+	ops     map[IROp]uint64
+	opList  []IROp
+	consts  map[Constant]uint64
+	cList   []Constant
+	callees map[Callee]uint64
+	ceeList []Callee
+	err     error // 遇到的第一个无法编码的值
+}
+
+func (e *binEncoder) uvarint(v uint64) { e.body = binary.AppendUvarint(e.body, v) }
+func (e *binEncoder) varint(v int64)   { e.body = binary.AppendVarint(e.body, v) }
+func (e *binEncoder) u8(v uint32)      { e.body = append(e.body, byte(v)) }
+func (e *binEncoder) temp(t IRTemp)    { e.uvarint(uint64(uint32(t + 1))) }
+
+func (e *binEncoder) op(op IROp) {
+	if _, ok := op.info(); !ok && e.err == nil {
+		e.err = fmt.Errorf("vex: invalid IROp %#x", uint32(op))
+	}
+	i, ok := e.ops[op]
+	if !ok {
+		i = uint64(len(e.opList))
+		e.ops[op] = i
+		e.opList = append(e.opList, op)
+	}
+	e.uvarint(i)
+}
+
+func (e *binEncoder) constant(c Constant) {
+	i, ok := e.consts[c]
+	if !ok {
+		i = uint64(len(e.cList))
+		e.consts[c] = i
+		e.cList = append(e.cList, c)
+	}
+	e.uvarint(i)
+}
+
+func (e *binEncoder) callee(c Callee) {
+	c.Addr = 0
+	i, ok := e.callees[c]
+	if !ok {
+		i = uint64(len(e.ceeList))
+		e.callees[c] = i
+		e.ceeList = append(e.ceeList, c)
+	}
+	e.uvarint(i)
+}
+
+func (e *binEncoder) regArray(r RegArray) {
+	e.varint(int64(r.Base))
+	e.u8(uint32(r.ElemTy))
+	e.varint(int64(r.NElems))
+}
+
+func (e *binEncoder) exprs(es []Expr) {
+	e.uvarint(uint64(len(es)))
+	for _, x := range es {
+		e.expr(x)
+	}
+}
+
+func (e *binEncoder) expr(x Expr) {
+	if x == nil {
+		e.u8(0)
+		return
+	}
+	e.u8(uint32(x.Tag()-IexBinder) + 1)
+	switch x := x.(type) {
+	case *BinderExpr:
+		e.varint(int64(x.Binder))
+	case *GetExpr:
+		e.varint(int64(x.Offset))
+		e.u8(uint32(x.Ty))
+	case *GetIExpr:
+		e.regArray(x.Descr)
+		e.expr(x.Ix)
+		e.varint(int64(x.Bias))
+	case *RdTmpExpr:
+		e.temp(x.Tmp)
+	case *QopExpr:
+		e.op(x.Op)
+		e.expr(x.Arg1)
+		e.expr(x.Arg2)
+		e.expr(x.Arg3)
+		e.expr(x.Arg4)
+	case *TriopExpr:
+		e.op(x.Op)
+		e.expr(x.Arg1)
+		e.expr(x.Arg2)
+		e.expr(x.Arg3)
+	case *BinopExpr:
+		e.op(x.Op)
+		e.expr(x.Arg1)
+		e.expr(x.Arg2)
+	case *UnopExpr:
+		e.op(x.Op)
+		e.expr(x.Arg)
+	case *LoadExpr:
+		e.u8(uint32(x.End))
+		e.u8(uint32(x.Ty))
+		e.expr(x.Addr)
+	case *ConstExpr:
+		e.constant(x.Con)
+	case *ITEExpr:
+		e.expr(x.Cond)
+		e.expr(x.IfTrue)
+		e.expr(x.IfFalse)
+	case *CCallExpr:
+		e.callee(x.Cee)
+		e.u8(uint32(x.RetTy))
+		e.exprs(x.Args)
+	case *VECRETExpr, *GSPTRExpr:
+	}
+}
+
+func (e *binEncoder) stmt(s Stmt) {
+	if s == nil {
+		if e.err == nil {
+			e.err = fmt.Errorf("vex: nil statement")
+		}
+		return
+	}
+	e.u8(uint32(s.Tag() - IstNoOp))
+	switch s := s.(type) {
+	case *NoOpStmt:
+	case *IMarkStmt:
+		e.uvarint(s.Addr)
+		e.uvarint(uint64(s.Len))
+		e.u8(uint32(s.Delta))
+	case *AbiHintStmt:
+		e.expr(s.Base)
+		e.varint(int64(s.Len))
+		e.expr(s.Nia)
+	case *PutStmt:
+		e.varint(int64(s.Offset))
+		e.expr(s.Data)
+	case *PutIStmt:
+		e.regArray(s.Descr)
+		e.expr(s.Ix)
+		e.varint(int64(s.Bias))
+		e.expr(s.Data)
+	case *WrTmpStmt:
+		e.temp(s.Tmp)
+		e.expr(s.Data)
+	case *StoreStmt:
+		e.u8(uint32(s.End))
+		e.expr(s.Addr)
+		e.expr(s.Data)
+	case *LoadGStmt:
+		e.u8(uint32(s.End))
+		e.u8(uint32(s.Cvt))
+		e.temp(s.Dst)
+		e.expr(s.Addr)
+		e.expr(s.Alt)
+		e.expr(s.Guard)
+	case *StoreGStmt:
+		e.u8(uint32(s.End))
+		e.expr(s.Addr)
+		e.expr(s.Data)
+		e.expr(s.Guard)
+	case *CASStmt:
+		e.temp(s.OldHi)
+		e.temp(s.OldLo)
+		e.u8(uint32(s.End))
+		e.expr(s.Addr)
+		e.expr(s.ExpdHi)
+		e.expr(s.ExpdLo)
+		e.expr(s.DataHi)
+		e.expr(s.DataLo)
+	case *LLSCStmt:
+		e.u8(uint32(s.End))
+		e.temp(s.Result)
+		e.expr(s.Addr)
+		e.expr(s.StoreData)
+	case *DirtyStmt:
+		e.callee(s.Cee)
+		e.expr(s.Guard)
+		e.exprs(s.Args)
+		e.temp(s.Tmp)
+		e.u8(uint32(s.MFx))
+		e.expr(s.MAddr)
+		e.varint(int64(s.MSize))
+		e.uvarint(uint64(len(s.FxState)))
+		for _, fx := range s.FxState {
+			e.u8(uint32(fx.Fx))
+			e.uvarint(uint64(fx.Offset))
+			e.uvarint(uint64(fx.Size))
+			e.u8(uint32(fx.NRepeats))
+			e.u8(uint32(fx.RepeatLen))
+		}
+	case *MBEStmt:
+		e.u8(uint32(s.Event))
+	case *ExitStmt:
+		e.expr(s.Guard)
+		e.constant(s.Dst)
+		e.u8(uint32(s.Jk))
+		e.varint(int64(s.OffsIP))
+	}
+}
+
+// appendBlock 将块体追加到 dst，块中有无效的 IROp 或 nil 语句时返回错误
+func appendBlock(dst []byte, b *Block) ([]byte, error) {
+	e := &binEncoder{ops: map[IROp]uint64{}, consts: map[Constant]uint64{}, callees: map[Callee]uint64{}}
+	e.uvarint(uint64(len(b.TypeEnv)))
+	for _, ty := range b.TypeEnv {
+		e.u8(uint32(ty))
+	}
+	e.uvarint(uint64(len(b.Stmts)))
+	for i, s := range b.Stmts {
+		e.stmt(s)
+		if e.err != nil {
+			return dst, fmt.Errorf("stmt %d: %w", i, e.err)
+		}
+	}
+	e.expr(b.Next)
+	e.u8(uint32(b.JumpKind))
+	e.varint(int64(b.OffsIP))
+	if e.err != nil {
+		return dst, fmt.Errorf("next: %w", e.err)
+	}
+
+	dst = binary.AppendUvarint(dst, uint64(b.Arch))
+	dst = binary.AppendUvarint(dst, uint64(len(e.opList)))
+	for _, op := range e.opList {
+		dst = appendString(dst, op.String())
+	}
+	dst = binary.AppendUvarint(dst, uint64(len(e.cList)))
+	for _, c := range e.cList {
+		dst = append(dst, byte(c.Tag))
+		dst = binary.AppendUvarint(dst, c.Bits)
+	}
+	dst = binary.AppendUvarint(dst, uint64(len(e.ceeList)))
+	for _, c := range e.ceeList {
+		dst = appendString(dst, c.Name)
+		dst = binary.AppendVarint(dst, int64(c.Regparms))
+		dst = binary.AppendUvarint(dst, uint64(c.McxMask))
+	}
+	return append(dst, e.body...), nil
+}
+
+func appendString(dst []byte, s string) []byte {
+	dst = binary.AppendUvarint(dst, uint64(len(s)))
+	return append(dst, s...)
+}
+
+// binDecoder 顺序读取块体，记录遇到的第一个错误
+type binDecoder struct {
+	data    []byte
+	err     error
+	ops     []IROp
+	consts  []Constant
+	callees []Callee
+}
+
+func (d *binDecoder) fail(format string, args ...any) {
+	if d.err == nil {
+		d.err = fmt.Errorf("%w: %s", ErrMalformed, fmt.Sprintf(format, args...))
+	}
+}
+
+func (d *binDecoder) uvarint() uint64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.fail("bad uvarint")
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *binDecoder) varint() int64 {
+	if d.err != nil {
+		return 0
+	}
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.fail("bad varint")
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *binDecoder) i32() int32 {
+	v := d.varint()
+	if v < math.MinInt32 || v > math.MaxInt32 {
+		d.fail("int32 out of range")
+	}
+	return int32(v)
+}
+
+func (d *binDecoder) u32() uint32 {
+	v := d.uvarint()
+	if v > math.MaxUint32 {
+		d.fail("uint32 out of range")
+	}
+	return uint32(v)
+}
+
+func (d *binDecoder) u8() byte {
+	if d.err != nil {
+		return 0
+	}
+	if len(d.data) == 0 {
+		d.fail("unexpected end of data")
+		return 0
+	}
+	b := d.data[0]
+	d.data = d.data[1:]
+	return b
+}
+
+// count 读取元素个数，每个元素至少占 minSize 字节
+func (d *binDecoder) count(minSize int) int {
+	n := d.uvarint()
+	if n > uint64(len(d.data)/minSize) {
+		d.fail("count %d exceeds data", n)
+		return 0
+	}
+	return int(n)
+}
+
+func (d *binDecoder) str() string {
+	n := d.count(1)
+	s := string(d.data[:n])
+	d.data = d.data[n:]
+	return s
+}
+
+func (d *binDecoder) temp() IRTemp {
+	return IRTemp(d.u32() - 1)
+}
+
+// decodeEnum 读取一个字节的枚举，值不在 names 中时报错
+func decodeEnum[T ~uint32](d *binDecoder, names map[T]string, base T) T {
+	v := base | T(d.u8())
+	if _, ok := names[v]; !ok && d.err == nil {
+		d.fail("invalid %T %#x", v, uint32(v))
+	}
+	return v
+}
+
+func (d *binDecoder) ty() IRType         { return decodeEnum(d, irTypeNames, ItyINVALID) }
+func (d *binDecoder) endness() IREndness { return decodeEnum(d, irEndnessNames, IendLE) }
+func (d *binDecoder) effect() IREffect   { return decodeEnum(d, irEffectNames, IfxNone) }
+
+func (d *binDecoder) op() IROp {
+	i := d.uvarint()
+	if i >= uint64(len(d.ops)) {
+		d.fail("op index %d out of range", i)
+		return IopINVALID
+	}
+	return d.ops[i]
+}
+
+func (d *binDecoder) constant() Constant {
+	i := d.uvarint()
+	if i >= uint64(len(d.consts)) {
+		d.fail("constant index %d out of range", i)
+		return Constant{}
+	}
+	return d.consts[i]
+}
+
+func (d *binDecoder) callee() Callee {
+	i := d.uvarint()
+	if i >= uint64(len(d.callees)) {
+		d.fail("callee index %d out of range", i)
+		return Callee{}
+	}
+	return d.callees[i]
+}
+
+func (d *binDecoder) regArray() RegArray {
+	return RegArray{Base: d.i32(), ElemTy: d.ty(), NElems: d.i32()}
+}
+
+func (d *binDecoder) exprs() []Expr {
+	n := d.count(1)
+	if n == 0 {
+		return nil
+	}
+	es := make([]Expr, n)
+	for i := range es {
+		es[i] = d.expr()
+	}
+	return es
+}
+
+func (d *binDecoder) expr() Expr {
+	tag := d.u8()
+	if d.err != nil || tag == 0 {
+		return nil
+	}
+	switch IexBinder + IRExprTag(tag-1) {
+	case IexBinder:
+		return &BinderExpr{Binder: d.i32()}
+	case IexGet:
+		return &GetExpr{Offset: d.i32(), Ty: d.ty()}
+	case IexGetI:
+		return &GetIExpr{Descr: d.regArray(), Ix: d.expr(), Bias: d.i32()}
+	case IexRdTmp:
+		return &RdTmpExpr{Tmp: d.temp()}
+	case IexQop:
+		return &QopExpr{Op: d.op(), Arg1: d.expr(), Arg2: d.expr(), Arg3: d.expr(), Arg4: d.expr()}
+	case IexTriop:
+		return &TriopExpr{Op: d.op(), Arg1: d.expr(), Arg2: d.expr(), Arg3: d.expr()}
+	case IexBinop:
+		return &BinopExpr{Op: d.op(), Arg1: d.expr(), Arg2: d.expr()}
+	case IexUnop:
+		return &UnopExpr{Op: d.op(), Arg: d.expr()}
+	case IexLoad:
+		return &LoadExpr{End: d.endness(), Ty: d.ty(), Addr: d.expr()}
+	case IexConst:
+		return &ConstExpr{Con: d.constant()}
+	case IexITE:
+		return &ITEExpr{Cond: d.expr(), IfTrue: d.expr(), IfFalse: d.expr()}
+	case IexCCall:
+		return &CCallExpr{Cee: d.callee(), RetTy: d.ty(), Args: d.exprs()}
+	case IexVECRET:
+		return &VECRETExpr{}
+	case IexGSPTR:
+		return &GSPTRExpr{}
+	}
+	d.fail("invalid expression tag %d", tag)
+	return nil
+}
+
+func (d *binDecoder) stmt() Stmt {
+	tag := IstNoOp | IRStmtTag(d.u8())
+	switch tag {
+	case IstNoOp:
+		return &NoOpStmt{}
+	case IstIMark:
+		return &IMarkStmt{Addr: d.uvarint(), Len: d.u32(), Delta: d.u8()}
+	case IstAbiHint:
+		return &AbiHintStmt{Base: d.expr(), Len: d.i32(), Nia: d.expr()}
+	case IstPut:
+		return &PutStmt{Offset: d.i32(), Data: d.expr()}
+	case IstPutI:
+		return &PutIStmt{Descr: d.regArray(), Ix: d.expr(), Bias: d.i32(), Data: d.expr()}
+	case IstWrTmp:
+		return &WrTmpStmt{Tmp: d.temp(), Data: d.expr()}
+	case IstStore:
+		return &StoreStmt{End: d.endness(), Addr: d.expr(), Data: d.expr()}
+	case IstLoadG:
+		return &LoadGStmt{End: d.endness(), Cvt: decodeEnum(d, irLoadGOpNames, ILGopInvalid), Dst: d.temp(),
+			Addr: d.expr(), Alt: d.expr(), Guard: d.expr()}
+	case IstStoreG:
+		return &StoreGStmt{End: d.endness(), Addr: d.expr(), Data: d.expr(), Guard: d.expr()}
+	case IstCAS:
+		return &CASStmt{OldHi: d.temp(), OldLo: d.temp(), End: d.endness(), Addr: d.expr(),
+			ExpdHi: d.expr(), ExpdLo: d.expr(), DataHi: d.expr(), DataLo: d.expr()}
+	case IstLLSC:
+		return &LLSCStmt{End: d.endness(), Result: d.temp(), Addr: d.expr(), StoreData: d.expr()}
+	case IstDirty:
+		s := &DirtyStmt{Cee: d.callee(), Guard: d.expr(), Args: d.exprs(), Tmp: d.temp(), MFx: d.effect(),
+			MAddr: d.expr(), MSize: d.i32()}
+		if n := d.count(5); n > 0 {
+			s.FxState = make([]FxState, n)
+			for i := range s.FxState {
+				s.FxState[i] = FxState{Fx: d.effect(), Offset: uint16(d.uvarint()), Size: uint16(d.uvarint()),
+					NRepeats: d.u8(), RepeatLen: d.u8()}
+			}
+		}
+		return s
+	case IstMBE:
+		return &MBEStmt{Event: decodeEnum(d, irMBusEventNames, ImbeFence)}
+	case IstExit:
+		return &ExitStmt{Guard: d.expr(), Dst: d.constant(), Jk: decodeEnum(d, irJumpKindNames, IjkInvalid),
+			OffsIP: d.i32()}
+	}
+	d.fail("invalid statement tag %#x", uint32(tag))
+	return nil
+}
+
+// decodeBlock 解析 appendBlock 写出的块体
+func decodeBlock(data []byte) (*Block, error) {
+	d := &binDecoder{data: data}
+	b := &Block{Arch: VexArch(d.u32())}
+	d.ops = make([]IROp, d.count(1))
+	for i := range d.ops {
+		name := d.str()
+		op, ok := ParseIROp(name)
+		if !ok && d.err == nil {
+			d.fail("unknown IROp %q", name)
+		}
+		d.ops[i] = op
+	}
+	d.consts = make([]Constant, d.count(2))
+	for i := range d.consts {
+		d.consts[i] = Constant{Tag: decodeEnum(d, irConstTagNames, IcoU1), Bits: d.uvarint()}
+	}
+	d.callees = make([]Callee, d.count(4))
+	for i := range d.callees {
+		d.callees[i] = Callee{Name: d.str(), Regparms: d.i32(), McxMask: d.u32()}
+	}
+	if n := d.count(1); n > 0 {
+		b.TypeEnv = make([]IRType, n)
+		for i := range b.TypeEnv {
+			b.TypeEnv[i] = d.ty()
+		}
+	}
+	b.Stmts = make([]Stmt, d.count(1))
+	for i := range b.Stmts {
+		b.Stmts[i] = d.stmt()
+	}
+	b.Next = d.expr()
+	b.JumpKind = decodeEnum(d, irJumpKindNames, IjkInvalid)
+	b.OffsIP = d.i32()
+	if d.err == nil && len(d.data) != 0 {
+		d.fail("%d trailing bytes", len(d.data))
+	}
+	if d.err != nil {
+		return nil, d.err
+	}
+	return b, nil
+}
+
+func checkVersion(v uint64) error {
+	if v != IRBinaryVersion {
+		return fmt.Errorf("%w: binary version %d, want %d", ErrVersion, v, IRBinaryVersion)
+	}
+	return nil
+}
+
+// MarshalBinary 将块编码为版本为 IRBinaryVersion 的二进制格式，块中有无效的 IROp 或 nil 语句时返回错误
+func (b *Block) MarshalBinary() ([]byte, error) {
+	dst := binary.AppendUvarint([]byte(blockMagic), IRBinaryVersion)
+	return appendBlock(dst, b)
+}
+
+// UnmarshalBinary 解析 MarshalBinary 的输出，版本不符时返回 ErrVersion，数据损坏时返回 ErrMalformed
+func (b *Block) UnmarshalBinary(data []byte) error {
+	if len(data) < len(blockMagic) || string(data[:len(blockMagic)]) != blockMagic {
+		return fmt.Errorf("%w: bad magic", ErrMalformed)
+	}
+	v, n := binary.Uvarint(data[len(blockMagic):])
+	if n <= 0 {
+		return fmt.Errorf("%w: bad version", ErrMalformed)
+	}
+	if err := checkVersion(v); err != nil {
+		return err
+	}
+	nb, err := decodeBlock(data[len(blockMagic)+n:])
+	if err != nil {
+		return err
+	}
+	*b = *nb
+	return nil
+}
+
+// BlockWriter 将多个块顺序写入流
+type BlockWriter struct {
+	w      *bufio.Writer
+	buf    []byte
+	header bool
+}
+
+// NewBlockWriter 创建写入 w 的 BlockWriter，流头在第一次写入时输出
+func NewBlockWriter(w io.Writer) *BlockWriter {
+	return &BlockWriter{w: bufio.NewWriter(w)}
+}
+
+// Write 向流中追加一个块，无法编码的块不写入流
+func (bw *BlockWriter) Write(b *Block) error {
+	buf, err := appendBlock(bw.buf[:0], b)
+	bw.buf = buf
+	if err != nil {
+		return err
+	}
+	if !bw.header {
+		hdr := binary.AppendUvarint([]byte(streamMagic), IRBinaryVersion)
+		if _, err := bw.w.Write(hdr); err != nil {
+			return err
+		}
+		bw.header = true
+	}
+	var n [binary.MaxVarintLen64]byte
+	if _, err := bw.w.Write(n[:binary.PutUvarint(n[:], uint64(len(bw.buf)))]); err != nil {
+		return err
+	}
+	_, err = bw.w.Write(bw.buf)
+	return err
+}
+
+// Flush 将缓冲的数据写入底层的 io.Writer
+func (bw *BlockWriter) Flush() error {
+	return bw.w.Flush()
+}
+
+// maxStreamBlock 是流中单个块的长度上限，防止损坏的长度字段导致过大的分配
+const maxStreamBlock = 64 << 20
+
+// BlockReader 从 BlockWriter 写出的流中顺序读取块
+type BlockReader struct {
+	r      *bufio.Reader
+	buf    []byte
+	header bool
+}
+
+// NewBlockReader 创建从 r 读取的 BlockReader
+func NewBlockReader(r io.Reader) *BlockReader {
+	return &BlockReader{r: bufio.NewReader(r)}
+}
+
+func (br *BlockReader) readHeader() error {
+	magic := make([]byte, len(streamMagic))
+	if _, err := io.ReadFull(br.r, magic); err != nil {
+		if err == io.EOF {
+			return io.EOF
+		}
+		return fmt.Errorf("%w: truncated stream header", ErrMalformed)
+	}
+	if string(magic) != streamMagic {
+		return fmt.Errorf("%w: bad magic", ErrMalformed)
+	}
+	v, err := binary.ReadUvarint(br.r)
+	if err != nil {
+		return fmt.Errorf("%w: bad version", ErrMalformed)
+	}
+	return checkVersion(v)
+}
+
+// Read 读取下一个块，流结束时返回 io.EOF
+func (br *BlockReader) Read() (*Block, error) {
+	if !br.header {
+		if err := br.readHeader(); err != nil {
+			return nil, err
+		}
+		br.header = true
+	}
+	n, err := binary.ReadUvarint(br.r)
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil || n > maxStreamBlock {
+		return nil, fmt.Errorf("%w: bad block length", ErrMalformed)
+	}
+	if uint64(cap(br.buf)) < n {
+		br.buf = make([]byte, n)
+	}
+	br.buf = br.buf[:n]
+	if _, err := io.ReadFull(br.r, br.buf); err != nil {
+		return nil, fmt.Errorf("%w: truncated block", ErrMalformed)
+	}
+	return decodeBlock(br.buf)
+}
+
+// CacheKey 标识一次提升的输入，可用作磁盘缓存的键。
+// 提升结果还取决于 LiftOptions，使用不同参数提升的块应放在不同的缓存中
+type CacheKey struct {
+	Arch VexArch
+	Addr uint64
+	Hash [sha256.Size]byte // 机器码的 SHA-256
+}
+
+// NewCacheKey 由架构、起始地址与机器码计算缓存键
+func NewCacheKey(arch VexArch, addr uint64, code []byte) CacheKey {
+	return CacheKey{Arch: arch, Addr: addr, Hash: sha256.Sum256(code)}
+}
+
+// String 返回形如 "VexArchAMD64:0x400000:<sha256>" 的文本，可直接用作文件名或数据库键
+func (k CacheKey) String() string {
+	return fmt.Sprintf("%v:%#x:%s", k.Arch, k.Addr, hex.EncodeToString(k.Hash[:]))
+}
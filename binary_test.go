@@ -0,0 +1,127 @@
+package vex_go
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+func TestBlockBinaryRoundTrip(t *testing.T) {
+	for _, blk := range liftTestBlocks(t) {
+		data, err := blk.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b Block
+		if err := b.UnmarshalBinary(data); err != nil {
+			t.Fatalf("%v: %v", blk.Arch, err)
+		}
+		// 宿主机地址不写入缓存，同一个块在不同进程中编码结果相同
+		clearCalleeAddrs(blk)
+		if cleared, _ := blk.MarshalBinary(); !bytes.Equal(cleared, data) {
+			t.Errorf("%v: encoding depends on callee addresses", blk.Arch)
+		}
+		if !reflect.DeepEqual(&b, blk) {
+			t.Errorf("%v: round trip mismatch:\n%s\n%s", blk.Arch, &b, blk)
+		}
+		js, _ := json.Marshal(blk)
+		if len(data)*5 > len(js) {
+			t.Errorf("%v: binary %d bytes, JSON %d bytes", blk.Arch, len(data), len(js))
+		}
+
+		// 任意截断或改写都只能返回错误，不能 panic
+		for i := range data {
+			if err := b.UnmarshalBinary(data[:i]); err == nil {
+				t.Fatalf("%v: truncated at %d decoded without error", blk.Arch, i)
+			}
+			bad := bytes.Clone(data)
+			bad[i] ^= 0xff
+			b.UnmarshalBinary(bad)
+		}
+	}
+
+	data, _ := (&Block{}).MarshalBinary()
+	data[len(blockMagic)] = IRBinaryVersion + 1
+	var b Block
+	if err := b.UnmarshalBinary(data); !errors.Is(err, ErrVersion) {
+		t.Errorf("expected ErrVersion, got %v", err)
+	}
+
+	// 解码器读不回的块在编码时就应失败
+	for _, blk := range []*Block{
+		{Stmts: []Stmt{nil}},
+		{Next: &UnopExpr{Op: IROp(0xfffff), Arg: &RdTmpExpr{Tmp: 0}}},
+	} {
+		if _, err := blk.MarshalBinary(); err == nil {
+			t.Errorf("%s: expected error", blk)
+		}
+		if err := NewBlockWriter(io.Discard).Write(blk); err == nil {
+			t.Errorf("%s: BlockWriter: expected error", blk)
+		}
+	}
+}
+
+func TestBlockStream(t *testing.T) {
+	blocks := liftTestBlocks(t)
+	for _, b := range blocks {
+		clearCalleeAddrs(b)
+	}
+	var buf bytes.Buffer
+	w := NewBlockWriter(&buf)
+	const n = 1000
+	for i := 0; i < n; i++ {
+		if err := w.Write(blocks[i%len(blocks)]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewBlockReader(bytes.NewReader(buf.Bytes()))
+	for i := 0; i < n; i++ {
+		b, err := r.Read()
+		if err != nil {
+			t.Fatalf("block %d: %v", i, err)
+		}
+		if !reflect.DeepEqual(b, blocks[i%len(blocks)]) {
+			t.Fatalf("block %d mismatch", i)
+		}
+	}
+	if _, err := r.Read(); err != io.EOF {
+		t.Fatalf("expected io.EOF, got %v", err)
+	}
+
+	if _, err := NewBlockReader(bytes.NewReader(nil)).Read(); err != io.EOF {
+		t.Fatalf("empty stream: expected io.EOF, got %v", err)
+	}
+	if _, err := NewBlockReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1])).Read(); err != nil {
+		t.Fatalf("first block of truncated stream: %v", err)
+	}
+	truncated := NewBlockReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	var err error
+	for err == nil {
+		_, err = truncated.Read()
+	}
+	if !errors.Is(err, ErrMalformed) {
+		t.Fatalf("expected ErrMalformed, got %v", err)
+	}
+}
+
+func TestCacheKey(t *testing.T) {
+	code := []byte{0x90, 0xc3}
+	k := NewCacheKey(VexArchAMD64, 0x400000, code)
+	if k != NewCacheKey(VexArchAMD64, 0x400000, []byte{0x90, 0xc3}) {
+		t.Fatal("cache key is not deterministic")
+	}
+	if k == NewCacheKey(VexArchAMD64, 0x400000, []byte{0x90, 0x90}) || k == NewCacheKey(VexArchX86, 0x400000, code) {
+		t.Fatal("cache key ignores its inputs")
+	}
+	want := "VexArchAMD64:0x400000:"
+	if s := k.String(); len(s) != len(want)+64 || s[:len(want)] != want {
+		t.Fatalf("unexpected key %s", s)
+	}
+}
@@ -0,0 +1,301 @@
+package vex_go
+
+import "fmt"
+
+// IRSBBuilder 在 Go 内存中逐条构造 IR 块，每一步都按 VEX 的类型规则检查。
+// 第一次出错后其余调用不再生效，错误由 Build 返回，因此可以连续调用而不必逐个检查：
+//
+//	b := NewIRSBBuilder(VexArchAMD64)
+//	t := b.Assign(b.Binop(IopAdd64, b.GetReg("rdi"), b.U64(8)))
+//	b.Store(b.RdTmp(t), b.GetReg("rsi"))
+//	b.SetNext(b.GetReg("rip"), IjkRet)
+//	blk, err := b.Build()
+type IRSBBuilder struct {
+	desc *ArchDescriptor
+	blk  Block
+	err  error
+}
+
+// NewIRSBBuilder 创建构造 arch 客户机代码块的 builder，架构未知时 Build 返回 ErrUnsupportedArch
+func NewIRSBBuilder(arch VexArch) *IRSBBuilder {
+	b := &IRSBBuilder{desc: GetArchDescriptor(arch), blk: Block{Arch: arch, JumpKind: IjkBoring}}
+	if b.desc == nil {
+		b.err = fmt.Errorf("%w: %v", ErrUnsupportedArch, arch)
+	} else {
+		b.blk.OffsIP = b.desc.IP
+	}
+	return b
+}
+
+func (b *IRSBBuilder) failf(format string, args ...any) {
+	if b.err == nil {
+		b.err = fmt.Errorf("%w: stmt %d: %s", ErrIllTyped, len(b.blk.Stmts), fmt.Sprintf(format, args...))
+	}
+}
+
+// typeOf 返回表达式的类型，无法推导时记录错误
+func (b *IRSBBuilder) typeOf(e Expr) IRType {
+	if e == nil {
+		b.failf("nil expression")
+		return ItyINVALID
+	}
+	ty := typeOfExpr(b.blk.TypeEnv, e)
+	if ty == ItyINVALID {
+		b.failf("cannot type %v", e)
+	}
+	return ty
+}
+
+// check 检查 e 的类型为 want
+func (b *IRSBBuilder) check(what string, e Expr, want IRType) {
+	if ty := b.typeOf(e); ty != ItyINVALID && ty != want {
+		b.failf("%s %v has type %s, want %s", what, e, ppType(ty), ppType(want))
+	}
+}
+
+func (b *IRSBBuilder) wordType() IRType {
+	if b.desc != nil && b.desc.WordSize == 4 {
+		return ItyI32
+	}
+	return ItyI64
+}
+
+func (b *IRSBBuilder) endness() IREndness {
+	if b.desc != nil && b.desc.DefaultEndness == VexEndnessBE {
+		return IendBE
+	}
+	return IendLE
+}
+
+func (b *IRSBBuilder) add(s Stmt) {
+	if b.err == nil {
+		b.blk.Stmts = append(b.blk.Stmts, s)
+	}
+}
+
+// NewTemp 分配一个类型为 ty 的临时变量
+func (b *IRSBBuilder) NewTemp(ty IRType) IRTemp {
+	if ty == ItyINVALID {
+		b.failf("temporary of type %s", ppType(ty))
+	}
+	b.blk.TypeEnv = append(b.blk.TypeEnv, ty)
+	return IRTemp(len(b.blk.TypeEnv) - 1)
+}
+
+// RdTmp 读取临时变量
+func (b *IRSBBuilder) RdTmp(t IRTemp) Expr {
+	if int(t) >= len(b.blk.TypeEnv) {
+		b.failf("undefined temporary %v", t)
+	}
+	return &RdTmpExpr{Tmp: t}
+}
+
+// Const 返回常量表达式
+func (b *IRSBBuilder) Const(c Constant) Expr {
+	return &ConstExpr{Con: c}
+}
+
+// U1 返回 I1 常量
+func (b *IRSBBuilder) U1(v bool) Expr {
+	c := Constant{Tag: IcoU1}
+	if v {
+		c.Bits = 1
+	}
+	return &ConstExpr{Con: c}
+}
+
+// U8 返回 I8 常量
+func (b *IRSBBuilder) U8(v uint8) Expr {
+	return &ConstExpr{Con: Constant{Tag: IcoU8, Bits: uint64(v)}}
+}
+
+// U16 返回 I16 常量
+func (b *IRSBBuilder) U16(v uint16) Expr {
+	return &ConstExpr{Con: Constant{Tag: IcoU16, Bits: uint64(v)}}
+}
+
+// U32 返回 I32 常量
+func (b *IRSBBuilder) U32(v uint32) Expr {
+	return &ConstExpr{Con: Constant{Tag: IcoU32, Bits: uint64(v)}}
+}
+
+// U64 返回 I64 常量
+func (b *IRSBBuilder) U64(v uint64) Expr {
+	return &ConstExpr{Con: Constant{Tag: IcoU64, Bits: v}}
+}
+
+// Word 返回架构字长的整数常量
+func (b *IRSBBuilder) Word(v uint64) Expr {
+	if b.wordType() == ItyI32 {
+		return b.U32(uint32(v))
+	}
+	return b.U64(v)
+}
+
+// Get 读取客户机状态中 offset 处类型为 ty 的值
+func (b *IRSBBuilder) Get(offset int32, ty IRType) Expr {
+	return &GetExpr{Offset: offset, Ty: ty}
+}
+
+// GetReg 按名称读取整个寄存器，类型由寄存器大小决定
+func (b *IRSBBuilder) GetReg(name string) Expr {
+	r, ok := b.reg(name)
+	if !ok {
+		return &GetExpr{Offset: 0, Ty: ItyINVALID}
+	}
+	return &GetExpr{Offset: r.Offset, Ty: regType(r)}
+}
+
+func (b *IRSBBuilder) reg(name string) (Register, bool) {
+	r, ok := LookupRegister(b.blk.Arch, name)
+	if !ok {
+		b.failf("unknown register %q for %v", name, b.blk.Arch)
+	}
+	return r, ok
+}
+
+// regType 返回与寄存器大小相同的整数或向量类型
+func regType(r Register) IRType {
+	switch r.Size {
+	case 1:
+		return ItyI8
+	case 2:
+		return ItyI16
+	case 4:
+		return ItyI32
+	case 8:
+		return ItyI64
+	case 16:
+		return ItyV128
+	case 32:
+		return ItyV256
+	}
+	return ItyINVALID
+}
+
+// op 检查操作码的参数个数与类型
+func (b *IRSBBuilder) op(op IROp, args ...Expr) {
+	if opResultType(op) == ItyINVALID {
+		b.failf("unknown IROp %v", op)
+		return
+	}
+	if n := op.Arity(); n != len(args) {
+		b.failf("%v takes %d args, got %d", op, n, len(args))
+		return
+	}
+	for i, want := range op.ArgTypes() {
+		b.check(fmt.Sprintf("%v arg %d", op, i+1), args[i], want)
+	}
+}
+
+// Unop 返回一元运算表达式
+func (b *IRSBBuilder) Unop(op IROp, arg Expr) Expr {
+	b.op(op, arg)
+	return &UnopExpr{Op: op, Arg: arg}
+}
+
+// Binop 返回二元运算表达式
+func (b *IRSBBuilder) Binop(op IROp, arg1, arg2 Expr) Expr {
+	b.op(op, arg1, arg2)
+	return &BinopExpr{Op: op, Arg1: arg1, Arg2: arg2}
+}
+
+// Triop 返回三元运算表达式
+func (b *IRSBBuilder) Triop(op IROp, arg1, arg2, arg3 Expr) Expr {
+	b.op(op, arg1, arg2, arg3)
+	return &TriopExpr{Op: op, Arg1: arg1, Arg2: arg2, Arg3: arg3}
+}
+
+// Qop 返回四元运算表达式
+func (b *IRSBBuilder) Qop(op IROp, arg1, arg2, arg3, arg4 Expr) Expr {
+	b.op(op, arg1, arg2, arg3, arg4)
+	return &QopExpr{Op: op, Arg1: arg1, Arg2: arg2, Arg3: arg3, Arg4: arg4}
+}
+
+// Load 以架构默认字节序从 addr 加载类型为 ty 的值
+func (b *IRSBBuilder) Load(ty IRType, addr Expr) Expr {
+	b.check("load address", addr, b.wordType())
+	return &LoadExpr{End: b.endness(), Ty: ty, Addr: addr}
+}
+
+// ITE 返回条件选择表达式，cond 为 I1，两个分支类型相同
+func (b *IRSBBuilder) ITE(cond, ifTrue, ifFalse Expr) Expr {
+	b.check("ITE condition", cond, ItyI1)
+	b.check("ITE false branch", ifFalse, b.typeOf(ifTrue))
+	return &ITEExpr{Cond: cond, IfTrue: ifTrue, IfFalse: ifFalse}
+}
+
+// IMark 标记一条客户机指令的开始
+func (b *IRSBBuilder) IMark(addr uint64, length uint32) {
+	b.add(&IMarkStmt{Addr: addr, Len: length})
+}
+
+// WrTmp 把 data 写入临时变量 t，二者类型必须相同
+func (b *IRSBBuilder) WrTmp(t IRTemp, data Expr) {
+	if int(t) >= len(b.blk.TypeEnv) {
+		b.failf("undefined temporary %v", t)
+		return
+	}
+	b.check(fmt.Sprintf("value for %v", t), data, b.blk.TypeEnv[t])
+	b.add(&WrTmpStmt{Tmp: t, Data: data})
+}
+
+// Assign 分配一个与 data 类型相同的临时变量并写入 data
+func (b *IRSBBuilder) Assign(data Expr) IRTemp {
+	t := b.NewTemp(b.typeOf(data))
+	b.WrTmp(t, data)
+	return t
+}
+
+// Put 把 data 写入客户机状态的 offset 处
+func (b *IRSBBuilder) Put(offset int32, data Expr) {
+	b.typeOf(data)
+	b.add(&PutStmt{Offset: offset, Data: data})
+}
+
+// PutReg 按名称写入整个寄存器，data 的大小必须与寄存器相同
+func (b *IRSBBuilder) PutReg(name string, data Expr) {
+	r, ok := b.reg(name)
+	if !ok {
+		return
+	}
+	if ty := b.typeOf(data); ty != ItyINVALID && GetIRTypeSize(ty) != int(r.Size) {
+		b.failf("value %v of type %s does not fit %d-byte register %s", data, ppType(ty), r.Size, r.Name)
+	}
+	b.add(&PutStmt{Offset: r.Offset, Data: data})
+}
+
+// Store 以架构默认字节序把 data 存入 addr
+func (b *IRSBBuilder) Store(addr, data Expr) {
+	b.check("store address", addr, b.wordType())
+	b.typeOf(data)
+	b.add(&StoreStmt{End: b.endness(), Addr: addr, Data: data})
+}
+
+// Exit 在 guard 为真时以 jk 跳转到 dst
+func (b *IRSBBuilder) Exit(guard Expr, dst uint64, jk IRJumpKind) {
+	b.check("exit guard", guard, ItyI1)
+	con := b.Word(dst).(*ConstExpr).Con
+	b.add(&ExitStmt{Guard: guard, Dst: con, Jk: jk, OffsIP: b.blk.OffsIP})
+}
+
+// SetNext 设置块结束后的跳转目标与跳转类型
+func (b *IRSBBuilder) SetNext(next Expr, jk IRJumpKind) {
+	b.check("next", next, b.wordType())
+	b.blk.Next, b.blk.JumpKind = next, jk
+}
+
+// Build 返回构造好的块。之前的任一步出错或未调用 SetNext 时返回错误。
+// 返回的块与 builder 不共享切片，builder 可以继续使用
+func (b *IRSBBuilder) Build() (*Block, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if b.blk.Next == nil {
+		return nil, fmt.Errorf("%w: block has no next expression", ErrIllTyped)
+	}
+	blk := b.blk
+	blk.TypeEnv = append([]IRType(nil), b.blk.TypeEnv...)
+	blk.Stmts = append([]Stmt(nil), b.blk.Stmts...)
+	return &blk, nil
+}
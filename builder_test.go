@@ -0,0 +1,103 @@
+package vex_go
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestIRSBBuilder(t *testing.T) {
+	b := NewIRSBBuilder(VexArchAMD64)
+	b.IMark(0x1000, 4)
+	t0 := b.Assign(b.Binop(IopAdd64, b.GetReg("rdi"), b.U64(8)))
+	t1 := b.NewTemp(ItyI64)
+	b.WrTmp(t1, b.Load(ItyI64, b.RdTmp(t0)))
+	b.Store(b.RdTmp(t0), b.GetReg("rsi"))
+	b.Exit(b.Binop(IopCmpEQ64, b.RdTmp(t1), b.U64(0)), 0x2000, IjkBoring)
+	b.PutReg("rax", b.RdTmp(t1))
+	b.SetNext(b.GetReg("rip"), IjkRet)
+	blk, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `IRSB {
+   t0:I64   t1:I64   
+
+   ------ IMark(0x1000, 4, 0) ------
+   t0 = Add64(GET:I64(72),0x8:I64)
+   t1 = LDle:I64(t0)
+   STle(t0) = GET:I64(64)
+   if (CmpEQ64(t1,0x0:I64)) { PUT(184) = 0x2000:I64; exit-Boring } 
+   PUT(16) = t1
+   PUT(184) = GET:I64(184); exit-Return
+}
+`
+	if got := blk.String(); got != want {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
+	if diags := Validate(blk); diags != nil {
+		t.Errorf("built block is invalid: %v", diags)
+	}
+	parsed, err := ParseIRSB(VexArchAMD64, blk.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(parsed, blk) {
+		t.Errorf("parsed block differs:\n%s", parsed)
+	}
+
+	// ARM 为 32 位，出口常量为 I32
+	b = NewIRSBBuilder(VexArchARM)
+	b.Exit(b.U1(true), 0x8000, IjkCall)
+	b.SetNext(b.Word(0x8004), IjkBoring)
+	if blk, err = b.Build(); err != nil {
+		t.Fatal(err)
+	}
+	if c := blk.Stmts[0].(*ExitStmt).Dst; c != (Constant{Tag: IcoU32, Bits: 0x8000}) {
+		t.Errorf("exit dst %v", c)
+	}
+}
+
+func TestIRSBBuilderErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		build func(b *IRSBBuilder)
+		msg   string
+	}{
+		{"binop arg", func(b *IRSBBuilder) {
+			b.Assign(b.Binop(IopAdd64, b.U32(1), b.U64(2)))
+		}, "Add64 arg 1 0x1:I32 has type I32, want I64"},
+		{"wrtmp", func(b *IRSBBuilder) {
+			b.WrTmp(b.NewTemp(ItyI32), b.U64(0))
+		}, "value for t0"},
+		{"undefined temp", func(b *IRSBBuilder) {
+			b.Put(16, b.RdTmp(3))
+		}, "undefined temporary t3"},
+		{"register", func(b *IRSBBuilder) {
+			b.PutReg("r0", b.U64(0))
+		}, `unknown register "r0"`},
+		{"register size", func(b *IRSBBuilder) {
+			b.PutReg("eax", b.U64(0))
+		}, "does not fit 4-byte register eax"},
+		{"guard", func(b *IRSBBuilder) {
+			b.Exit(b.U64(1), 0, IjkBoring)
+		}, "exit guard"},
+		{"no next", func(b *IRSBBuilder) {}, "no next"},
+	}
+	for _, tt := range tests {
+		b := NewIRSBBuilder(VexArchAMD64)
+		b.IMark(0x1000, 1)
+		tt.build(b)
+		if tt.msg != "no next" {
+			b.SetNext(b.U64(0), IjkBoring)
+		}
+		_, err := b.Build()
+		if !errors.Is(err, ErrIllTyped) || !strings.Contains(err.Error(), tt.msg) {
+			t.Errorf("%s: got %v, want %q", tt.name, err, tt.msg)
+		}
+	}
+	if _, err := NewIRSBBuilder(VexArchInvalid).Build(); !errors.Is(err, ErrUnsupportedArch) {
+		t.Errorf("expected ErrUnsupportedArch, got %v", err)
+	}
+}
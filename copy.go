@@ -0,0 +1,179 @@
+package vex_go
+
+import (
+	"unsafe"
+)
+
+// Copy 将 VEX 内存池中的 IRSB 深拷贝为 Go 内存中的 Block。
+// 返回的 Block 的 Arch 为 VexArchInvalid，由调用方按需填写
+func (isb *IRSb) Copy() *Block {
+	b := &Block{
+		Arch:     VexArchInvalid,
+		Next:     isb.Next.Copy(),
+		JumpKind: isb.JumpKind,
+		OffsIP:   isb.OffsIP,
+	}
+	if isb.TyEnv != nil {
+		b.TypeEnv = make([]IRType, int(isb.TyEnv.TypesUsed))
+		for i := range b.TypeEnv {
+			b.TypeEnv[i] = isb.TyEnv.GetType(i)
+		}
+	}
+	b.Stmts = make([]Stmt, 0, int(isb.StmtsUsed))
+	for i := 0; i < int(isb.StmtsUsed); i++ {
+		b.Stmts = append(b.Stmts, isb.GetStmt(i).Copy())
+	}
+	return b
+}
+
+// Copy 将常量复制到 Go 内存
+func (c *IRConst) Copy() Constant {
+	p := unsafe.Pointer(&c.Value)
+	var raw uint64
+	switch c.Tag {
+	case IcoU1, IcoU8:
+		raw = uint64(*(*uint8)(p))
+	case IcoU16, IcoV128:
+		raw = uint64(*(*uint16)(p))
+	case IcoU32, IcoF32, IcoF32i, IcoV256:
+		raw = uint64(*(*uint32)(p))
+	default:
+		raw = *(*uint64)(p)
+	}
+	return Constant{Tag: c.Tag, Bits: raw}
+}
+
+func copyRegArray(d *IRRegArray) RegArray {
+	return RegArray{Base: d.Base, ElemTy: d.ElemTy, NElems: d.NElems}
+}
+
+func copyCallee(cee *IRCallee) Callee {
+	return Callee{Name: cee.Name(), Regparms: cee.Regparms, Addr: uintptr(cee.Addr), McxMask: cee.McxMask}
+}
+
+// copyExprVec 复制以 NULL 结尾的表达式数组
+func copyExprVec(args **IRExpr) []Expr {
+	var res []Expr
+	for _, a := range exprVec(args) {
+		res = append(res, a.Copy())
+	}
+	return res
+}
+
+// Copy 将语句深拷贝到 Go 内存
+func (i *IRStmt) Copy() Stmt {
+	if i == nil {
+		return nil
+	}
+	switch i.Tag {
+	case IstNoOp:
+		return &NoOpStmt{}
+	case IstIMark:
+		s := i.AsIMark()
+		return &IMarkStmt{Addr: s.Addr, Len: s.Len, Delta: s.Delta}
+	case IstAbiHint:
+		s := i.AsAbiHint()
+		return &AbiHintStmt{Base: s.Base.Copy(), Len: s.Len, Nia: s.Nia.Copy()}
+	case IstPut:
+		s := i.AsPut()
+		return &PutStmt{Offset: s.Offset, Data: s.Data.Copy()}
+	case IstPutI:
+		d := i.AsPutI().Details
+		return &PutIStmt{Descr: copyRegArray(d.Descr), Ix: d.Ix.Copy(), Bias: d.Bias, Data: d.Data.Copy()}
+	case IstWrTmp:
+		s := i.AsWrTmp()
+		return &WrTmpStmt{Tmp: s.Tmp, Data: s.Data.Copy()}
+	case IstStore:
+		s := i.AsStore()
+		return &StoreStmt{End: s.End, Addr: s.Addr.Copy(), Data: s.Data.Copy()}
+	case IstLoadG:
+		d := i.AsLoadG().Details
+		return &LoadGStmt{End: d.End, Cvt: d.Cvt, Dst: d.Dst, Addr: d.Addr.Copy(), Alt: d.Alt.Copy(), Guard: d.Guard.Copy()}
+	case IstStoreG:
+		d := i.AsStoreG().Details
+		return &StoreGStmt{End: d.End, Addr: d.Addr.Copy(), Data: d.Data.Copy(), Guard: d.Guard.Copy()}
+	case IstCAS:
+		d := i.AsCAS().Details
+		return &CASStmt{OldHi: d.OldHi, OldLo: d.OldLo, End: d.End, Addr: d.Addr.Copy(),
+			ExpdHi: d.ExpdHi.Copy(), ExpdLo: d.ExpdLo.Copy(), DataHi: d.DataHi.Copy(), DataLo: d.DataLo.Copy()}
+	case IstLLSC:
+		s := i.AsLLSC()
+		return &LLSCStmt{End: s.End, Result: s.Result, Addr: s.Addr.Copy(), StoreData: s.StoreData.Copy()}
+	case IstDirty:
+		d := i.AsDirty().Details
+		s := &DirtyStmt{
+			Cee:   copyCallee(d.Cee),
+			Guard: d.Guard.Copy(),
+			Args:  copyExprVec(d.Args),
+			Tmp:   d.Tmp,
+			MFx:   d.MFx,
+			MAddr: d.MAddr.Copy(),
+			MSize: d.MSize,
+		}
+		for _, fx := range d.GetFxState() {
+			s.FxState = append(s.FxState, FxState{
+				Fx:        fx.Effect(),
+				Offset:    fx.Offset,
+				Size:      fx.Size,
+				NRepeats:  fx.NRepeats,
+				RepeatLen: fx.RepeatLen,
+			})
+		}
+		return s
+	case IstMBE:
+		return &MBEStmt{Event: i.AsMBE().Event}
+	case IstExit:
+		s := i.AsExit()
+		return &ExitStmt{Guard: s.Guard.Copy(), Dst: s.Dst.Copy(), Jk: s.Jk, OffsIP: s.OffsIP}
+	default:
+		panic("unknown IRStmtTag")
+	}
+}
+
+// Copy 将表达式深拷贝到 Go 内存，nil 表达式返回 nil
+func (i *IRExpr) Copy() Expr {
+	if i == nil {
+		return nil
+	}
+	switch i.Tag {
+	case IexBinder:
+		return &BinderExpr{Binder: i.AsBinder().Binder}
+	case IexGet:
+		e := i.AsGet()
+		return &GetExpr{Offset: e.Offset, Ty: e.Ty}
+	case IexGetI:
+		e := i.AsGetI()
+		return &GetIExpr{Descr: copyRegArray(e.Descr), Ix: e.Ix.Copy(), Bias: e.Bias}
+	case IexRdTmp:
+		return &RdTmpExpr{Tmp: i.AsRdTmp().Tmp}
+	case IexQop:
+		d := i.AsQop().Details
+		return &QopExpr{Op: d.Op, Arg1: d.Arg1.Copy(), Arg2: d.Arg2.Copy(), Arg3: d.Arg3.Copy(), Arg4: d.Arg4.Copy()}
+	case IexTriop:
+		d := i.AsTriop().Details
+		return &TriopExpr{Op: d.Op, Arg1: d.Arg1.Copy(), Arg2: d.Arg2.Copy(), Arg3: d.Arg3.Copy()}
+	case IexBinop:
+		e := i.AsBinop()
+		return &BinopExpr{Op: e.Op, Arg1: e.Arg1.Copy(), Arg2: e.Arg2.Copy()}
+	case IexUnop:
+		e := i.AsUnop()
+		return &UnopExpr{Op: e.Op, Arg: e.Arg.Copy()}
+	case IexLoad:
+		e := i.AsLoad()
+		return &LoadExpr{End: e.End, Ty: e.Ty, Addr: e.Addr.Copy()}
+	case IexConst:
+		return &ConstExpr{Con: i.AsConst().Con.Copy()}
+	case IexITE:
+		e := i.AsITE()
+		return &ITEExpr{Cond: e.Cond.Copy(), IfTrue: e.IfTrue.Copy(), IfFalse: e.IfFalse.Copy()}
+	case IexCCall:
+		e := i.AsCCall()
+		return &CCallExpr{Cee: copyCallee(e.Cee), RetTy: e.RetTy, Args: copyExprVec(e.Args)}
+	case IexVECRET:
+		return &VECRETExpr{}
+	case IexGSPTR:
+		return &GSPTRExpr{}
+	default:
+		panic("unknown IRExprTag")
+	}
+}
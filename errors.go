@@ -0,0 +1,117 @@
+package vex_go
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInit            = errors.New("vex: initialization failed")
+	ErrEmptyInput      = errors.New("vex: empty input")
+	ErrInvalidOptions  = errors.New("vex: invalid lift options")
+	ErrUnsupportedArch = errors.New("vex: unsupported architecture")
+	ErrDecode          = errors.New("vex: cannot decode instruction")
+	ErrTruncated       = errors.New("vex: truncated instruction")
+	ErrVexPanic        = errors.New("vex: internal panic")
+	ErrVexAssert       = errors.New("vex: assertion failed")
+	ErrVersion         = errors.New("vex: unsupported serialization version")
+	ErrMalformed       = errors.New("vex: malformed serialized IR")
+	ErrIllTyped        = errors.New("vex: ill-typed IR")
+)
+
+// LiftError 描述一次失败的提升，可通过 errors.Is 判断具体原因
+type LiftError struct {
+	Err  error   // 失败原因，为 ErrEmptyInput、ErrDecode 等之一或包装了它们的错误
+	Arch VexArch // 客户机架构
+	Addr uint64  // 提升的起始地址
+	Log  string  // 本次提升期间 VEX 输出的日志
+}
+
+func (e *LiftError) Error() string {
+	msg := fmt.Sprintf("%v (arch %v, addr %#x)", e.Err, e.Arch, e.Addr)
+	if log := strings.TrimSpace(e.Log); log != "" {
+		msg += ": " + log
+	}
+	return msg
+}
+
+func (e *LiftError) Unwrap() error {
+	return e.Err
+}
+
+// ParseError 描述文本 IR 中的语法错误
+type ParseError struct {
+	Line int    // 从 1 开始的行号
+	Col  int    // 从 1 开始的列号
+	Msg  string // 错误描述
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("vex: parse error at %d:%d: %s", e.Line, e.Col, e.Msg)
+}
+
+// classifyVexLog 根据 VEX 在 failure_exit 前输出的日志判断失败原因
+func classifyVexLog(log string) error {
+	// 第一条指令超出了输入长度，见 guest_generic_bb_to_IR.c 中的 bb_to_IR
+	if strings.Contains(log, "Not enough bytes given to decode even a single instruction") {
+		return ErrTruncated
+	}
+	if strings.Contains(log, "Assertion") {
+		return ErrVexAssert
+	}
+	return ErrVexPanic
+}
+
+// isSupportedArch 判断当前编译的 VEX 是否支持该客户机架构
+func isSupportedArch(v VexArch) bool {
+	switch v {
+	case VexArchX86, VexArchAMD64, VexArchARM, VexArchARM64, VexArchPPC32, VexArchPPC64,
+		VexArchS390X, VexArchMIPS32, VexArchMIPS64, VexArchRISCV64:
+		return true
+	}
+	return false
+}
+
+// insnLenHint 根据指令的前几个字节推算第一条指令至少需要的字节数，无法推算时返回 0。
+// 定长指令集在输入不足时，VEX 会把补零后的字节当作无法解码的指令，
+// 此时需要借助它区分截断与真正的非法指令。x86/AMD64 由 vexLift 换用不同的填充重新提升来判断
+func insnLenHint(v VexArch, mc []byte, insAddr uint64, en VexEndness) int {
+	switch v {
+	case VexArchARM64, VexArchPPC32, VexArchPPC64, VexArchMIPS32, VexArchMIPS64:
+		return 4
+	case VexArchARM:
+		if insAddr&1 == 0 {
+			return 4
+		}
+		// Thumb：首个半字的高 5 位为 0b11101/0b11110/0b11111 时为 32 位指令
+		if len(mc) < 2 {
+			return 2
+		}
+		hw := uint16(mc[0]) | uint16(mc[1])<<8
+		if en == VexEndnessBE {
+			hw = uint16(mc[0])<<8 | uint16(mc[1])
+		}
+		if hw>>11 >= 0x1d {
+			return 4
+		}
+		return 2
+	case VexArchRISCV64:
+		// 低 2 位不为 0b11 的是压缩指令
+		if mc[0]&3 != 3 {
+			return 2
+		}
+		return 4
+	case VexArchS390X:
+		// 操作码高 2 位决定指令长度
+		switch mc[0] >> 6 {
+		case 0:
+			return 2
+		case 1, 2:
+			return 4
+		default:
+			return 6
+		}
+	}
+	return 0
+}
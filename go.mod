@@ -1,3 +1,3 @@
 module github.com/misslng/vex-go
 
-go 1.22.1
+go 1.23
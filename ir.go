@@ -0,0 +1,374 @@
+package vex_go
+
+// 本文件定义完全位于 Go 内存中的 IR 模型。与 typs.go 中直接映射 VEX 内存的
+// IRSb/IRStmt/IRExpr 不同，这里的节点由 GC 管理，可以长期保存、放入缓存或 map 中。
+
+// Block 是 IRSB 的 Go 副本
+type Block struct {
+	Arch     VexArch    // 客户机架构，未知时为 VexArchInvalid
+	TypeEnv  []IRType   // 临时变量 tN 的类型为 TypeEnv[N]
+	Stmts    []Stmt     // 语句列表
+	Next     Expr       // 块执行完毕后的跳转目标
+	JumpKind IRJumpKind // 块结束的跳转类型
+	OffsIP   int32      // IP 在客户机状态中的偏移
+}
+
+// TypeOfTemp 返回临时变量的类型，越界时返回 ItyINVALID
+func (b *Block) TypeOfTemp(t IRTemp) IRType {
+	if int(t) >= len(b.TypeEnv) {
+		return ItyINVALID
+	}
+	return b.TypeEnv[t]
+}
+
+// typeOfExpr 按 VEX typeOfIRExpr 的规则推导表达式类型，无法推导时返回 ItyINVALID
+func typeOfExpr(env []IRType, e Expr) IRType {
+	switch e := e.(type) {
+	case *GetExpr:
+		return e.Ty
+	case *GetIExpr:
+		return e.Descr.ElemTy
+	case *RdTmpExpr:
+		if int(e.Tmp) < len(env) {
+			return env[e.Tmp]
+		}
+	case *QopExpr:
+		return opResultType(e.Op)
+	case *TriopExpr:
+		return opResultType(e.Op)
+	case *BinopExpr:
+		return opResultType(e.Op)
+	case *UnopExpr:
+		return opResultType(e.Op)
+	case *LoadExpr:
+		return e.Ty
+	case *ConstExpr:
+		return e.Con.Type()
+	case *CCallExpr:
+		return e.RetTy
+	case *ITEExpr:
+		return typeOfExpr(env, e.IfTrue)
+	}
+	return ItyINVALID
+}
+
+// typeOfLoadGOp 返回 LoadG 转换后的结果类型与实际加载的类型，与 VEX 的 typeOfIRLoadGOp 一致
+func typeOfLoadGOp(cvt IRLoadGOp) (res, arg IRType) {
+	switch cvt {
+	case ILGopIdentV128:
+		return ItyV128, ItyV128
+	case ILGopIdent64:
+		return ItyI64, ItyI64
+	case ILGopIdent32:
+		return ItyI32, ItyI32
+	case ILGop16Uto32, ILGop16Sto32:
+		return ItyI32, ItyI16
+	case ILGop8Uto32, ILGop8Sto32:
+		return ItyI32, ItyI8
+	}
+	return ItyINVALID, ItyINVALID
+}
+
+// opResultType 与 IROp.ResultType 相同，但未知操作码返回 ItyINVALID 而不是 panic
+func opResultType(op IROp) IRType {
+	if i, ok := op.info(); ok {
+		return i.res
+	}
+	return ItyINVALID
+}
+
+// Constant 是 IRConst 的 Go 副本，Bits 保存按 Tag 宽度截断后的原始位
+type Constant struct {
+	Tag  IRConstTag
+	Bits uint64
+}
+
+// RegArray 描述客户机状态中被当作循环数组访问的区域（GetI/PutI）
+type RegArray struct {
+	Base   int32  `json:"base"`    // 数组起始偏移
+	ElemTy IRType `json:"elem_ty"` // 元素类型
+	NElems int32  `json:"n_elems"` // 元素个数
+}
+
+// Callee 描述 CCall/Dirty 调用的辅助函数
+type Callee struct {
+	Name     string  `json:"name"`
+	Regparms int32   `json:"regparms"`
+	Addr     uintptr `json:"-"` // 宿主机上的函数地址，仅用于打印，随进程变化因此不参与序列化
+	McxMask  uint32  `json:"mcx_mask"`
+}
+
+// FxState 描述 Dirty 调用对客户机状态的一段访问
+type FxState struct {
+	Fx        IREffect `json:"fx"`
+	Offset    uint16   `json:"offset"`
+	Size      uint16   `json:"size"`
+	NRepeats  uint8    `json:"n_repeats"`
+	RepeatLen uint8    `json:"repeat_len"`
+}
+
+// Stmt 是 Go 侧 IR 语句，具体类型为 *XxxStmt
+type Stmt interface {
+	Tag() IRStmtTag
+	String() string // 与 VEX ppIRStmt 的输出一致
+	stmtNode()
+}
+
+// Expr 是 Go 侧 IR 表达式，具体类型为 *XxxExpr
+type Expr interface {
+	Tag() IRExprTag
+	String() string // 与 VEX ppIRExpr 的输出一致
+	exprNode()
+}
+
+// NoOpStmt 表示空操作
+type NoOpStmt struct{}
+
+// IMarkStmt 表示指令标记
+type IMarkStmt struct {
+	Addr  uint64 // 指令地址
+	Len   uint32 // 指令长度
+	Delta uint8  // PC编码偏移
+}
+
+// AbiHintStmt 表示 ABI 提示
+type AbiHintStmt struct {
+	Base Expr  // 未定义块的起始地址
+	Len  int32 // 未定义块的长度
+	Nia  Expr  // 下一条指令的地址
+}
+
+// PutStmt 表示写入固定偏移的寄存器
+type PutStmt struct {
+	Offset int32
+	Data   Expr
+}
+
+// PutIStmt 表示写入非固定偏移的寄存器
+type PutIStmt struct {
+	Descr RegArray
+	Ix    Expr
+	Bias  int32
+	Data  Expr
+}
+
+// WrTmpStmt 表示临时变量赋值
+type WrTmpStmt struct {
+	Tmp  IRTemp
+	Data Expr
+}
+
+// StoreStmt 表示内存存储
+type StoreStmt struct {
+	End  IREndness
+	Addr Expr
+	Data Expr
+}
+
+// LoadGStmt 表示有条件的加载
+type LoadGStmt struct {
+	End   IREndness
+	Cvt   IRLoadGOp
+	Dst   IRTemp
+	Addr  Expr
+	Alt   Expr // 不加载时写入 Dst 的值
+	Guard Expr
+}
+
+// StoreGStmt 表示有条件的存储
+type StoreGStmt struct {
+	End   IREndness
+	Addr  Expr
+	Data  Expr
+	Guard Expr
+}
+
+// CASStmt 表示原子比较和交换，单元素 CAS 的 OldHi 为 IRTempInvalid，ExpdHi/DataHi 为 nil
+type CASStmt struct {
+	OldHi  IRTemp
+	OldLo  IRTemp
+	End    IREndness
+	Addr   Expr
+	ExpdHi Expr
+	ExpdLo Expr
+	DataHi Expr
+	DataLo Expr
+}
+
+// LLSCStmt 表示 Load-Linked/Store-Conditional，StoreData 为 nil 时表示 LL
+type LLSCStmt struct {
+	End       IREndness
+	Result    IRTemp
+	Addr      Expr
+	StoreData Expr
+}
+
+// DirtyStmt 表示调用有副作用的C函数
+type DirtyStmt struct {
+	Cee     Callee
+	Guard   Expr
+	Args    []Expr
+	Tmp     IRTemp // 无返回值时为 IRTempInvalid
+	MFx     IREffect
+	MAddr   Expr // MFx 为 IfxNone 时为 nil
+	MSize   int32
+	FxState []FxState
+}
+
+// MBEStmt 表示内存总线事件
+type MBEStmt struct {
+	Event IRMBusEvent
+}
+
+// ExitStmt 表示条件退出
+type ExitStmt struct {
+	Guard  Expr
+	Dst    Constant
+	Jk     IRJumpKind
+	OffsIP int32
+}
+
+func (*NoOpStmt) Tag() IRStmtTag    { return IstNoOp }
+func (*IMarkStmt) Tag() IRStmtTag   { return IstIMark }
+func (*AbiHintStmt) Tag() IRStmtTag { return IstAbiHint }
+func (*PutStmt) Tag() IRStmtTag     { return IstPut }
+func (*PutIStmt) Tag() IRStmtTag    { return IstPutI }
+func (*WrTmpStmt) Tag() IRStmtTag   { return IstWrTmp }
+func (*StoreStmt) Tag() IRStmtTag   { return IstStore }
+func (*LoadGStmt) Tag() IRStmtTag   { return IstLoadG }
+func (*StoreGStmt) Tag() IRStmtTag  { return IstStoreG }
+func (*CASStmt) Tag() IRStmtTag     { return IstCAS }
+func (*LLSCStmt) Tag() IRStmtTag    { return IstLLSC }
+func (*DirtyStmt) Tag() IRStmtTag   { return IstDirty }
+func (*MBEStmt) Tag() IRStmtTag     { return IstMBE }
+func (*ExitStmt) Tag() IRStmtTag    { return IstExit }
+
+func (*NoOpStmt) stmtNode()    {}
+func (*IMarkStmt) stmtNode()   {}
+func (*AbiHintStmt) stmtNode() {}
+func (*PutStmt) stmtNode()     {}
+func (*PutIStmt) stmtNode()    {}
+func (*WrTmpStmt) stmtNode()   {}
+func (*StoreStmt) stmtNode()   {}
+func (*LoadGStmt) stmtNode()   {}
+func (*StoreGStmt) stmtNode()  {}
+func (*CASStmt) stmtNode()     {}
+func (*LLSCStmt) stmtNode()    {}
+func (*DirtyStmt) stmtNode()   {}
+func (*MBEStmt) stmtNode()     {}
+func (*ExitStmt) stmtNode()    {}
+
+// BinderExpr 表示 VEX 内部的模式匹配绑定器
+type BinderExpr struct {
+	Binder int32
+}
+
+// GetExpr 表示从固定偏移读取寄存器
+type GetExpr struct {
+	Offset int32
+	Ty     IRType
+}
+
+// GetIExpr 表示从非固定偏移读取寄存器
+type GetIExpr struct {
+	Descr RegArray
+	Ix    Expr
+	Bias  int32
+}
+
+// RdTmpExpr 表示读取临时变量
+type RdTmpExpr struct {
+	Tmp IRTemp
+}
+
+// QopExpr 表示四元操作
+type QopExpr struct {
+	Op   IROp
+	Arg1 Expr
+	Arg2 Expr
+	Arg3 Expr
+	Arg4 Expr
+}
+
+// TriopExpr 表示三元操作
+type TriopExpr struct {
+	Op   IROp
+	Arg1 Expr
+	Arg2 Expr
+	Arg3 Expr
+}
+
+// BinopExpr 表示二元操作
+type BinopExpr struct {
+	Op   IROp
+	Arg1 Expr
+	Arg2 Expr
+}
+
+// UnopExpr 表示一元操作
+type UnopExpr struct {
+	Op  IROp
+	Arg Expr
+}
+
+// LoadExpr 表示从内存加载
+type LoadExpr struct {
+	End  IREndness
+	Ty   IRType
+	Addr Expr
+}
+
+// ConstExpr 表示常量表达式
+type ConstExpr struct {
+	Con Constant
+}
+
+// ITEExpr 表示 if-then-else 表达式
+type ITEExpr struct {
+	Cond    Expr
+	IfTrue  Expr
+	IfFalse Expr
+}
+
+// CCallExpr 表示调用纯C函数
+type CCallExpr struct {
+	Cee   Callee
+	RetTy IRType
+	Args  []Expr
+}
+
+// VECRETExpr 仅出现在 Dirty 参数中，表示向量返回值
+type VECRETExpr struct{}
+
+// GSPTRExpr 仅出现在 Dirty 参数中，表示客户机状态指针
+type GSPTRExpr struct{}
+
+func (*BinderExpr) Tag() IRExprTag { return IexBinder }
+func (*GetExpr) Tag() IRExprTag    { return IexGet }
+func (*GetIExpr) Tag() IRExprTag   { return IexGetI }
+func (*RdTmpExpr) Tag() IRExprTag  { return IexRdTmp }
+func (*QopExpr) Tag() IRExprTag    { return IexQop }
+func (*TriopExpr) Tag() IRExprTag  { return IexTriop }
+func (*BinopExpr) Tag() IRExprTag  { return IexBinop }
+func (*UnopExpr) Tag() IRExprTag   { return IexUnop }
+func (*LoadExpr) Tag() IRExprTag   { return IexLoad }
+func (*ConstExpr) Tag() IRExprTag  { return IexConst }
+func (*ITEExpr) Tag() IRExprTag    { return IexITE }
+func (*CCallExpr) Tag() IRExprTag  { return IexCCall }
+func (*VECRETExpr) Tag() IRExprTag { return IexVECRET }
+func (*GSPTRExpr) Tag() IRExprTag  { return IexGSPTR }
+
+func (*BinderExpr) exprNode() {}
+func (*GetExpr) exprNode()    {}
+func (*GetIExpr) exprNode()   {}
+func (*RdTmpExpr) exprNode()  {}
+func (*QopExpr) exprNode()    {}
+func (*TriopExpr) exprNode()  {}
+func (*BinopExpr) exprNode()  {}
+func (*UnopExpr) exprNode()   {}
+func (*LoadExpr) exprNode()   {}
+func (*ConstExpr) exprNode()  {}
+func (*ITEExpr) exprNode()    {}
+func (*CCallExpr) exprNode()  {}
+func (*VECRETExpr) exprNode() {}
+func (*GSPTRExpr) exprNode()  {}
@@ -0,0 +1,112 @@
+package vex_go
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCopyOutlivesArena(t *testing.T) {
+	opts := DefaultLiftOptions()
+	opts.OptLevel = 0
+	l := NewLifter(VexArchAMD64, VexEndnessLE, opts)
+	// lock cmpxchg [rdi], rsi; cpuid; add rax, 8; jz 0
+	mc := []byte{0xf0, 0x48, 0x0f, 0xb1, 0x37, 0x0f, 0xa2, 0x48, 0x83, 0xc0, 0x08, 0x74, 0xf3}
+	r, err := l.Lift(mc, 0x400000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Block.Arch != VexArchAMD64 || r.IRSB != nil {
+		t.Fatal("lift result is not Go owned")
+	}
+
+	// 再次提升以覆盖 VEX 内存池
+	for i := 0; i < 10; i++ {
+		if _, err := l.Lift([]byte{0x90, 0x90, 0xc3}, 0x1000); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	seen := map[IRStmtTag]bool{}
+	var hasCCall bool
+	for _, s := range r.Block.Stmts {
+		seen[s.Tag()] = true
+		switch s := s.(type) {
+		case *IMarkStmt:
+			if s.Addr < 0x400000 || s.Addr >= 0x400000+uint64(len(mc)) {
+				t.Fatalf("IMark address %#x out of range", s.Addr)
+			}
+		case *CASStmt:
+			if s.End != IendLE || s.OldHi != IRTempInvalid || s.ExpdHi != nil {
+				t.Fatalf("unexpected CAS %+v", s)
+			}
+		case *DirtyStmt:
+			if s.Cee.Name == "" || len(s.FxState) == 0 || s.FxState[0].Fx < IfxRead {
+				t.Fatalf("unexpected dirty call %+v", s)
+			}
+		case *WrTmpStmt:
+			if int(s.Tmp) >= len(r.Block.TypeEnv) {
+				t.Fatalf("temp t%d outside type environment", s.Tmp)
+			}
+			if c, ok := s.Data.(*CCallExpr); ok && c.Cee.Name != "" && len(c.Args) > 0 {
+				hasCCall = true
+			}
+		case *ExitStmt:
+			if s.Dst.Tag != IcoU64 || s.Dst.Bits != 0x400000 {
+				t.Fatalf("unexpected exit target %+v", s.Dst)
+			}
+		}
+	}
+	for _, tag := range []IRStmtTag{IstIMark, IstCAS, IstDirty, IstExit, IstPut, IstWrTmp} {
+		if !seen[tag] {
+			t.Fatalf("statement %v missing from copy", tag)
+		}
+	}
+	if !hasCCall {
+		t.Fatal("CCall missing from copy")
+	}
+	if r.Block.Next == nil || r.Block.JumpKind != IjkBoring {
+		t.Fatalf("unexpected block end %+v %v", r.Block.Next, r.Block.JumpKind)
+	}
+}
+
+func TestDetailViews(t *testing.T) {
+	opts := DefaultLiftOptions()
+	opts.OptLevel = 0
+	l := NewLifter(VexArchAMD64, VexEndnessLE, opts)
+	// lock cmpxchg [rdi], rsi; cpuid
+	mc := []byte{0xf0, 0x48, 0x0f, 0xb1, 0x37, 0x0f, 0xa2}
+	err := l.Do(mc, 0x400000, func(r *LiftResult) {
+		var cas *IRCAS
+		var dirty *IRDirty
+		for i := 0; i < int(r.IRSB.StmtsUsed); i++ {
+			switch s := r.IRSB.GetStmt(i); s.Tag {
+			case IstCAS:
+				cas = s.AsCAS().Details
+			case IstDirty:
+				dirty = s.AsDirty().Details
+			}
+		}
+		if cas == nil || dirty == nil {
+			t.Fatal("CAS or Dirty missing")
+		}
+		if cas.End != IendLE || cas.OldHi != IRTempInvalid || cas.ExpdHi != nil || cas.DataLo == nil {
+			t.Fatalf("unexpected CAS %+v", cas)
+		}
+		if cas.ExpdLo.Tag != IexRdTmp || cas.Addr.Tag != IexRdTmp {
+			t.Fatalf("unexpected CAS operands %v %v", cas.ExpdLo.Tag, cas.Addr.Tag)
+		}
+		if !strings.HasPrefix(dirty.Cee.Name(), "amd64g_dirtyhelper_CPUID") || dirty.Guard.Tag != IexConst || dirty.MFx != IfxNone {
+			t.Fatalf("unexpected dirty call %s %+v", dirty.Cee.Name(), dirty)
+		}
+		if args := dirty.GetArgs(); len(args) != 1 || args[0].Tag != IexGSPTR {
+			t.Fatalf("unexpected dirty args %v", args)
+		}
+		fx := dirty.GetFxState()
+		if len(fx) == 0 || fx[0].Effect() != IfxModify {
+			t.Fatalf("unexpected fx state %+v", fx)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,187 @@
+package vex_go
+
+import (
+	"math"
+	"math/big"
+)
+
+func (c Constant) mustBe(tag IRConstTag) {
+	if c.Tag != tag {
+		panic("wrong type")
+	}
+}
+
+func (c Constant) U1() bool {
+	c.mustBe(IcoU1)
+	return c.Bits != 0
+}
+
+func (c Constant) U8() uint8 {
+	c.mustBe(IcoU8)
+	return uint8(c.Bits)
+}
+
+func (c Constant) U16() uint16 {
+	c.mustBe(IcoU16)
+	return uint16(c.Bits)
+}
+
+func (c Constant) U32() uint32 {
+	c.mustBe(IcoU32)
+	return uint32(c.Bits)
+}
+
+func (c Constant) U64() uint64 {
+	c.mustBe(IcoU64)
+	return c.Bits
+}
+
+func (c Constant) F32() float32 {
+	c.mustBe(IcoF32)
+	return math.Float32frombits(uint32(c.Bits))
+}
+
+func (c Constant) F32i() uint32 {
+	c.mustBe(IcoF32i)
+	return uint32(c.Bits)
+}
+
+func (c Constant) F64() float64 {
+	c.mustBe(IcoF64)
+	return math.Float64frombits(c.Bits)
+}
+
+func (c Constant) F64i() uint64 {
+	c.mustBe(IcoF64i)
+	return c.Bits
+}
+
+func (c Constant) V128() uint16 {
+	c.mustBe(IcoV128)
+	return uint16(c.Bits)
+}
+
+func (c Constant) V256() uint32 {
+	c.mustBe(IcoV256)
+	return uint32(c.Bits)
+}
+
+func (c Constant) Type() IRType {
+	return constTagType(c.Tag)
+}
+
+func (c Constant) IsVector() bool {
+	return c.Tag == IcoV128 || c.Tag == IcoV256
+}
+
+// AsUint64 返回常量的原始位（浮点数为 IEEE754 编码），向量常量无法用 64 位表示，会 panic
+func (c Constant) AsUint64() uint64 {
+	if c.IsVector() {
+		panic("wrong type")
+	}
+	return c.Bits
+}
+
+// AsBigInt 以无符号整数返回常量的完整位模式。
+// V128/V256 的每一位掩码对应一个字节，置位的字节为 0xFF
+func (c Constant) AsBigInt() *big.Int {
+	if !c.IsVector() {
+		return new(big.Int).SetUint64(c.Bits)
+	}
+	lanes := 16
+	if c.Tag == IcoV256 {
+		lanes = 32
+	}
+	res := new(big.Int)
+	for i := lanes - 1; i >= 0; i-- {
+		res.Lsh(res, 8)
+		if c.Bits>>uint(i)&1 != 0 {
+			res.Or(res, big.NewInt(0xFF))
+		}
+	}
+	return res
+}
+
+// constTagType 返回常量类型对应的 IRType，与 VEX 的 typeOfIRConst 一致
+func constTagType(tag IRConstTag) IRType {
+	switch tag {
+	case IcoU1:
+		return ItyI1
+	case IcoU8:
+		return ItyI8
+	case IcoU16:
+		return ItyI16
+	case IcoU32:
+		return ItyI32
+	case IcoU64:
+		return ItyI64
+	case IcoF32, IcoF32i:
+		return ItyF32
+	case IcoF64, IcoF64i:
+		return ItyF64
+	case IcoV128:
+		return ItyV128
+	case IcoV256:
+		return ItyV256
+	default:
+		panic("unknown IRConstTag")
+	}
+}
+
+// 以下为 VEX 内存中 IRConst 的类型化访问方法，标签不匹配时 panic
+
+func (c *IRConst) U1() bool {
+	return c.Copy().U1()
+}
+
+func (c *IRConst) U8() uint8 {
+	return c.Copy().U8()
+}
+
+func (c *IRConst) U16() uint16 {
+	return c.Copy().U16()
+}
+
+func (c *IRConst) U32() uint32 {
+	return c.Copy().U32()
+}
+
+func (c *IRConst) U64() uint64 {
+	return c.Copy().U64()
+}
+
+func (c *IRConst) F32() float32 {
+	return c.Copy().F32()
+}
+
+func (c *IRConst) F32i() uint32 {
+	return c.Copy().F32i()
+}
+
+func (c *IRConst) F64() float64 {
+	return c.Copy().F64()
+}
+
+func (c *IRConst) F64i() uint64 {
+	return c.Copy().F64i()
+}
+
+func (c *IRConst) V128() uint16 {
+	return c.Copy().V128()
+}
+
+func (c *IRConst) V256() uint32 {
+	return c.Copy().V256()
+}
+
+func (c *IRConst) Type() IRType {
+	return constTagType(c.Tag)
+}
+
+func (c *IRConst) AsUint64() uint64 {
+	return c.Copy().AsUint64()
+}
+
+func (c *IRConst) AsBigInt() *big.Int {
+	return c.Copy().AsBigInt()
+}
@@ -0,0 +1,168 @@
+package vex_go
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type irOpInfo struct {
+	name string
+	res  IRType
+	args [4]IRType
+}
+
+func (op IROp) info() (*irOpInfo, bool) {
+	if op < IopINVALID || op >= IopLAST {
+		return nil, false
+	}
+	return &irOpInfos[op-IopINVALID], true
+}
+
+// String 返回与 VEX ppIROp 一致的名称，如 Add64、CmpEQ32Fx4
+func (op IROp) String() string {
+	if i, ok := op.info(); ok {
+		return i.name
+	}
+	return fmt.Sprintf("IROp(%#x)", uint32(op))
+}
+
+var irOpsByName = func() map[string]IROp {
+	m := make(map[string]IROp, len(irOpInfos))
+	for i := range irOpInfos {
+		m[irOpInfos[i].name] = IopINVALID + IROp(i)
+	}
+	return m
+}()
+
+// ParseIROp 根据 ppIROp 的名称（如 Add64）查找操作码
+func ParseIROp(name string) (IROp, bool) {
+	op, ok := irOpsByName[name]
+	return op, ok
+}
+
+// TypeOfPrimop 返回操作码的结果与参数类型，未使用的参数为 ItyINVALID，与 VEX 的 typeOfPrimop 一致
+func TypeOfPrimop(op IROp) (res, arg1, arg2, arg3, arg4 IRType) {
+	i, ok := op.info()
+	if !ok || op == IopINVALID {
+		panic("unknown IROp")
+	}
+	return i.res, i.args[0], i.args[1], i.args[2], i.args[3]
+}
+
+// ResultType 返回操作结果的类型
+func (op IROp) ResultType() IRType {
+	res, _, _, _, _ := TypeOfPrimop(op)
+	return res
+}
+
+// ArgTypes 返回各操作数的类型
+func (op IROp) ArgTypes() []IRType {
+	_, a1, a2, a3, a4 := TypeOfPrimop(op)
+	return []IRType{a1, a2, a3, a4}[:op.Arity()]
+}
+
+// Arity 返回操作数个数（1-4）
+func (op IROp) Arity() int {
+	i, ok := op.info()
+	if !ok {
+		return 0
+	}
+	n := 0
+	for n < len(i.args) && i.args[n] != ItyINVALID {
+		n++
+	}
+	return n
+}
+
+var (
+	arithPrefixes = []string{"Add", "Sub", "Mul", "Div", "Mod", "Neg", "Abs", "Avg", "Max", "Min",
+		"QAdd", "QSub", "QDMul", "QRDMul", "PwAdd", "Sad", "Sqrt", "Recp", "RSqrt", "MAdd", "MSub",
+		"NegF", "AbsF", "Scale", "PolynomialMul"}
+	cmpPrefixes  = []string{"Cmp", "CasCmp", "ExpCmp"}
+	convPattern  = regexp.MustCompile(`[0-9A-Z]to[0-9A-Z]`)
+	lanesPattern = regexp.MustCompile(`(\d+)[A-Z]*(0?)x(\d+)`)
+	floatLanes   = regexp.MustCompile(`\d+F0?x\d+`)
+)
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if strings.HasPrefix(s, p) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsArithmetic 判断是否为算术运算（加减乘除、取负、绝对值、最值、平方根等）
+func (op IROp) IsArithmetic() bool {
+	return op != IopINVALID && hasAnyPrefix(op.String(), arithPrefixes)
+}
+
+// IsComparison 判断是否为比较运算
+func (op IROp) IsComparison() bool {
+	return op != IopINVALID && hasAnyPrefix(op.String(), cmpPrefixes)
+}
+
+// IsConversion 判断是否为类型转换（扩展、截断、整数与浮点互转、位模式重解释等）
+func (op IROp) IsConversion() bool {
+	name := op.String()
+	if op == IopINVALID || strings.HasPrefix(name, "DivMod") {
+		return false
+	}
+	return convPattern.MatchString(name) || strings.HasPrefix(name, "Reinterp")
+}
+
+// Lanes 返回 SIMD 操作的通道位宽与通道数，标量操作返回 (0, 0)。通道格式取名称中第一个 NxM，
+// 如 Perm8x16x2 为 (8, 16)。Add32F0x4 等 F0x 形式只对最低通道运算，其余通道照搬第一个操作数，返回的通道数为 1
+func (op IROp) Lanes() (width, count int) {
+	m := lanesPattern.FindStringSubmatch(op.String())
+	if m == nil {
+		return 0, 0
+	}
+	width, _ = strconv.Atoi(m[1])
+	if m[2] == "0" {
+		return width, 1
+	}
+	count, _ = strconv.Atoi(m[3])
+	return width, count
+}
+
+// IsSIMD 判断是否为按通道执行的 SIMD 操作，只对最低通道运算的 F0x 形式不算
+func (op IROp) IsSIMD() bool {
+	_, count := op.Lanes()
+	return count > 1
+}
+
+// IsFloat 判断是否为浮点（含十进制浮点）操作
+func (op IROp) IsFloat() bool {
+	i, ok := op.info()
+	if !ok || op == IopINVALID {
+		return false
+	}
+	if floatLanes.MatchString(i.name) || i.res.IsFloat() {
+		return true
+	}
+	for _, t := range i.args {
+		if t.IsFloat() {
+			return true
+		}
+	}
+	return false
+}
+
+// HasRoundingMode 判断第一个操作数是否为舍入模式（Ity_I32 的 IRRoundingMode）
+func (op IROp) HasRoundingMode() bool {
+	i, ok := op.info()
+	return ok && op.Arity() >= 2 && i.args[0] == ItyI32 && op.IsFloat()
+}
+
+// IsFloat 判断是否为二进制或十进制浮点类型
+func (t IRType) IsFloat() bool {
+	switch t {
+	case ItyF16, ItyF32, ItyF64, ItyF128, ItyD32, ItyD64, ItyD128:
+		return true
+	}
+	return false
+}
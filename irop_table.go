@@ -0,0 +1,1067 @@
+package vex_go
+
+// irOpInfos 由 VEX 的 ppIROp 与 typeOfPrimop 生成，下标为 op - IopINVALID。
+// ppIROp 未收录的操作码使用 libvex_ir.h 中的枚举名
+var irOpInfos = [...]irOpInfo{
+	{"INVALID", ItyINVALID, [4]IRType{ItyINVALID, ItyINVALID, ItyINVALID, ItyINVALID}},
+	{"Add8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                            // IopAdd8
+	{"Add16", ItyI16, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                        // IopAdd16
+	{"Add32", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                        // IopAdd32
+	{"Add64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                        // IopAdd64
+	{"Sub8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                            // IopSub8
+	{"Sub16", ItyI16, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                        // IopSub16
+	{"Sub32", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                        // IopSub32
+	{"Sub64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                        // IopSub64
+	{"Mul8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                            // IopMul8
+	{"Mul16", ItyI16, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                        // IopMul16
+	{"Mul32", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                        // IopMul32
+	{"Mul64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                        // IopMul64
+	{"Or8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                             // IopOr8
+	{"Or16", ItyI16, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                         // IopOr16
+	{"Or32", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                         // IopOr32
+	{"Or64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                         // IopOr64
+	{"And8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                            // IopAnd8
+	{"And16", ItyI16, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                        // IopAnd16
+	{"And32", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                        // IopAnd32
+	{"And64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                        // IopAnd64
+	{"Xor8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                            // IopXor8
+	{"Xor16", ItyI16, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                        // IopXor16
+	{"Xor32", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                        // IopXor32
+	{"Xor64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                        // IopXor64
+	{"Shl8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                            // IopShl8
+	{"Shl16", ItyI16, [4]IRType{ItyI16, ItyI8, ItyINVALID, ItyINVALID}},                         // IopShl16
+	{"Shl32", ItyI32, [4]IRType{ItyI32, ItyI8, ItyINVALID, ItyINVALID}},                         // IopShl32
+	{"Shl64", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                         // IopShl64
+	{"Shr8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                            // IopShr8
+	{"Shr16", ItyI16, [4]IRType{ItyI16, ItyI8, ItyINVALID, ItyINVALID}},                         // IopShr16
+	{"Shr32", ItyI32, [4]IRType{ItyI32, ItyI8, ItyINVALID, ItyINVALID}},                         // IopShr32
+	{"Shr64", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                         // IopShr64
+	{"Sar8", ItyI8, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                            // IopSar8
+	{"Sar16", ItyI16, [4]IRType{ItyI16, ItyI8, ItyINVALID, ItyINVALID}},                         // IopSar16
+	{"Sar32", ItyI32, [4]IRType{ItyI32, ItyI8, ItyINVALID, ItyINVALID}},                         // IopSar32
+	{"Sar64", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                         // IopSar64
+	{"CmpEQ8", ItyI1, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                          // IopCmpEQ8
+	{"CmpEQ16", ItyI1, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                       // IopCmpEQ16
+	{"CmpEQ32", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                       // IopCmpEQ32
+	{"CmpEQ64", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopCmpEQ64
+	{"CmpNE8", ItyI1, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                          // IopCmpNE8
+	{"CmpNE16", ItyI1, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                       // IopCmpNE16
+	{"CmpNE32", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                       // IopCmpNE32
+	{"CmpNE64", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopCmpNE64
+	{"Not8", ItyI8, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                       // IopNot8
+	{"Not16", ItyI16, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopNot16
+	{"Not32", ItyI32, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopNot32
+	{"Not64", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopNot64
+	{"CasCmpEQ8", ItyI1, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                       // IopCasCmpEQ8
+	{"CasCmpEQ16", ItyI1, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                    // IopCasCmpEQ16
+	{"CasCmpEQ32", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopCasCmpEQ32
+	{"CasCmpEQ64", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopCasCmpEQ64
+	{"CasCmpNE8", ItyI1, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                       // IopCasCmpNE8
+	{"CasCmpNE16", ItyI1, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                    // IopCasCmpNE16
+	{"CasCmpNE32", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopCasCmpNE32
+	{"CasCmpNE64", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopCasCmpNE64
+	{"ExpCmpNE8", ItyI1, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                       // IopExpCmpNE8
+	{"ExpCmpNE16", ItyI1, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                    // IopExpCmpNE16
+	{"ExpCmpNE32", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopExpCmpNE32
+	{"ExpCmpNE64", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopExpCmpNE64
+	{"MullS8", ItyI16, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                         // IopMullS8
+	{"MullS16", ItyI32, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                      // IopMullS16
+	{"MullS32", ItyI64, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopMullS32
+	{"MullS64", ItyI128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMullS64
+	{"MullU8", ItyI16, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                         // IopMullU8
+	{"MullU16", ItyI32, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                      // IopMullU16
+	{"MullU32", ItyI64, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopMullU32
+	{"MullU64", ItyI128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMullU64
+	{"Clz64", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopClz64
+	{"Clz32", ItyI32, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopClz32
+	{"Ctz64", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopCtz64
+	{"Ctz32", ItyI32, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopCtz32
+	{"CmpLT32S", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopCmpLT32S
+	{"CmpLT64S", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopCmpLT64S
+	{"CmpLE32S", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopCmpLE32S
+	{"CmpLE64S", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopCmpLE64S
+	{"CmpLT32U", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopCmpLT32U
+	{"CmpLT64U", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopCmpLT64U
+	{"CmpLE32U", ItyI1, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopCmpLE32U
+	{"CmpLE64U", ItyI1, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopCmpLE64U
+	{"CmpNEZ8", ItyI1, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopCmpNEZ8
+	{"CmpNEZ16", ItyI1, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopCmpNEZ16
+	{"CmpNEZ32", ItyI1, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopCmpNEZ32
+	{"CmpNEZ64", ItyI1, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopCmpNEZ64
+	{"CmpwNEZ32", ItyI32, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopCmpwNEZ32
+	{"CmpwNEZ64", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopCmpwNEZ64
+	{"Left8", ItyI8, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                      // IopLeft8
+	{"Left16", ItyI16, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopLeft16
+	{"Left32", ItyI32, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopLeft32
+	{"Left64", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopLeft64
+	{"Max32U", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                       // IopMax32U
+	{"CmpORD32U", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopCmpORD32U
+	{"CmpORD64U", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopCmpORD64U
+	{"CmpORD32S", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopCmpORD32S
+	{"CmpORD64S", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopCmpORD64S
+	{"DivU32", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                       // IopDivU32
+	{"DivS32", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                       // IopDivS32
+	{"DivU64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopDivU64
+	{"DivS64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopDivS64
+	{"DivU64E", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopDivU64E
+	{"DivS64E", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopDivS64E
+	{"DivU32E", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopDivU32E
+	{"DivS32E", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopDivS32E
+	{"DivModU64to32", ItyI64, [4]IRType{ItyI64, ItyI32, ItyINVALID, ItyINVALID}},                // IopDivModU64to32
+	{"DivModS64to32", ItyI64, [4]IRType{ItyI64, ItyI32, ItyINVALID, ItyINVALID}},                // IopDivModS64to32
+	{"DivModU128to64", ItyI128, [4]IRType{ItyI128, ItyI64, ItyINVALID, ItyINVALID}},             // IopDivModU128to64
+	{"DivModS128to64", ItyI128, [4]IRType{ItyI128, ItyI64, ItyINVALID, ItyINVALID}},             // IopDivModS128to64
+	{"DivModS64to64", ItyI128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},               // IopDivModS64to64
+	{"8Uto16", ItyI16, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop8Uto16
+	{"8Uto32", ItyI32, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop8Uto32
+	{"8Uto64", ItyI64, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop8Uto64
+	{"16Uto32", ItyI32, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                  // Iop16Uto32
+	{"16Uto64", ItyI64, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                  // Iop16Uto64
+	{"32Uto64", ItyI64, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                  // Iop32Uto64
+	{"8Sto16", ItyI16, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop8Sto16
+	{"8Sto32", ItyI32, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop8Sto32
+	{"8Sto64", ItyI64, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop8Sto64
+	{"16Sto32", ItyI32, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                  // Iop16Sto32
+	{"16Sto64", ItyI64, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                  // Iop16Sto64
+	{"32Sto64", ItyI64, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                  // Iop32Sto64
+	{"64to8", ItyI8, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                     // Iop64to8
+	{"32to8", ItyI8, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                     // Iop32to8
+	{"64to16", ItyI16, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // Iop64to16
+	{"16to8", ItyI8, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                     // Iop16to8
+	{"16HIto8", ItyI8, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                   // Iop16HIto8
+	{"8HLto16", ItyI16, [4]IRType{ItyI8, ItyI8, ItyINVALID, ItyINVALID}},                        // Iop8HLto16
+	{"32to16", ItyI16, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                   // Iop32to16
+	{"32HIto16", ItyI16, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                 // Iop32HIto16
+	{"16HLto32", ItyI32, [4]IRType{ItyI16, ItyI16, ItyINVALID, ItyINVALID}},                     // Iop16HLto32
+	{"64to32", ItyI32, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // Iop64to32
+	{"64HIto32", ItyI32, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                 // Iop64HIto32
+	{"32HLto64", ItyI64, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // Iop32HLto64
+	{"128to64", ItyI64, [4]IRType{ItyI128, ItyINVALID, ItyINVALID, ItyINVALID}},                 // Iop128to64
+	{"128HIto64", ItyI64, [4]IRType{ItyI128, ItyINVALID, ItyINVALID, ItyINVALID}},               // Iop128HIto64
+	{"64HLto128", ItyI128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // Iop64HLto128
+	{"Not1", ItyI1, [4]IRType{ItyI1, ItyINVALID, ItyINVALID, ItyINVALID}},                       // IopNot1
+	{"32to1", ItyI1, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                     // Iop32to1
+	{"64to1", ItyI1, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                     // Iop64to1
+	{"1Uto8", ItyI8, [4]IRType{ItyI1, ItyINVALID, ItyINVALID, ItyINVALID}},                      // Iop1Uto8
+	{"1Uto32", ItyI32, [4]IRType{ItyI1, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop1Uto32
+	{"1Uto64", ItyI64, [4]IRType{ItyI1, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop1Uto64
+	{"1Sto8", ItyI8, [4]IRType{ItyI1, ItyINVALID, ItyINVALID, ItyINVALID}},                      // Iop1Sto8
+	{"1Sto16", ItyI16, [4]IRType{ItyI1, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop1Sto16
+	{"1Sto32", ItyI32, [4]IRType{ItyI1, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop1Sto32
+	{"1Sto64", ItyI64, [4]IRType{ItyI1, ItyINVALID, ItyINVALID, ItyINVALID}},                    // Iop1Sto64
+	{"AddF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                           // IopAddF64
+	{"SubF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                           // IopSubF64
+	{"MulF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                           // IopMulF64
+	{"DivF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                           // IopDivF64
+	{"AddF32", ItyF32, [4]IRType{ItyI32, ItyF32, ItyF32, ItyINVALID}},                           // IopAddF32
+	{"SubF32", ItyF32, [4]IRType{ItyI32, ItyF32, ItyF32, ItyINVALID}},                           // IopSubF32
+	{"MulF32", ItyF32, [4]IRType{ItyI32, ItyF32, ItyF32, ItyINVALID}},                           // IopMulF32
+	{"DivF32", ItyF32, [4]IRType{ItyI32, ItyF32, ItyF32, ItyINVALID}},                           // IopDivF32
+	{"AddF64r32", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                        // IopAddF64r32
+	{"SubF64r32", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                        // IopSubF64r32
+	{"MulF64r32", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                        // IopMulF64r32
+	{"DivF64r32", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                        // IopDivF64r32
+	{"NegF64", ItyF64, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopNegF64
+	{"AbsF64", ItyF64, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopAbsF64
+	{"NegF32", ItyF32, [4]IRType{ItyF32, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopNegF32
+	{"AbsF32", ItyF32, [4]IRType{ItyF32, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopAbsF32
+	{"SqrtF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                      // IopSqrtF64
+	{"SqrtF32", ItyF32, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                      // IopSqrtF32
+	{"CmpF64", ItyI32, [4]IRType{ItyF64, ItyF64, ItyINVALID, ItyINVALID}},                       // IopCmpF64
+	{"CmpF32", ItyI32, [4]IRType{ItyF32, ItyF32, ItyINVALID, ItyINVALID}},                       // IopCmpF32
+	{"CmpF128", ItyI32, [4]IRType{ItyF128, ItyF128, ItyINVALID, ItyINVALID}},                    // IopCmpF128
+	{"F64toI16S", ItyI16, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                    // IopF64toI16S
+	{"F64toI32S", ItyI32, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                    // IopF64toI32S
+	{"F64toI64S", ItyI64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                    // IopF64toI64S
+	{"F64toI64U", ItyI64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                    // IopF64toI64U
+	{"F64toI32U", ItyI32, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                    // IopF64toI32U
+	{"I32StoF64", ItyF64, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopI32StoF64
+	{"I64StoF64", ItyF64, [4]IRType{ItyI32, ItyI64, ItyINVALID, ItyINVALID}},                    // IopI64StoF64
+	{"I64UtoF64", ItyF64, [4]IRType{ItyI32, ItyI64, ItyINVALID, ItyINVALID}},                    // IopI64UtoF64
+	{"I64UtoF32", ItyF32, [4]IRType{ItyI32, ItyI64, ItyINVALID, ItyINVALID}},                    // IopI64UtoF32
+	{"I32UtoF32", ItyF32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopI32UtoF32
+	{"I32UtoF64", ItyF64, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopI32UtoF64
+	{"F32toI32S", ItyI32, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                    // IopF32toI32S
+	{"F32toI64S", ItyI64, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                    // IopF32toI64S
+	{"F32toI32U", ItyI32, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                    // IopF32toI32U
+	{"F32toI64U", ItyI64, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                    // IopF32toI64U
+	{"I32StoF32", ItyF32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopI32StoF32
+	{"I64StoF32", ItyF32, [4]IRType{ItyI32, ItyI64, ItyINVALID, ItyINVALID}},                    // IopI64StoF32
+	{"F32toF64", ItyF64, [4]IRType{ItyF32, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopF32toF64
+	{"F64toF32", ItyF32, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                     // IopF64toF32
+	{"ReinterpF64asI64", ItyI64, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReinterpF64asI64
+	{"ReinterpI64asF64", ItyF64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReinterpI64asF64
+	{"ReinterpF32asI32", ItyI32, [4]IRType{ItyF32, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReinterpF32asI32
+	{"ReinterpI32asF32", ItyF32, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReinterpI32asF32
+	{"F64HLtoF128", ItyF128, [4]IRType{ItyF64, ItyF64, ItyINVALID, ItyINVALID}},                 // IopF64HLtoF128
+	{"F128HItoF64", ItyF64, [4]IRType{ItyF128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopF128HItoF64
+	{"F128LOtoF64", ItyF64, [4]IRType{ItyF128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopF128LOtoF64
+	{"AddF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyF128, ItyINVALID}},                       // IopAddF128
+	{"SubF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyF128, ItyINVALID}},                       // IopSubF128
+	{"MulF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyF128, ItyINVALID}},                       // IopMulF128
+	{"DivF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyF128, ItyINVALID}},                       // IopDivF128
+	{"MAddF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyF128, ItyF128}},                         // IopMAddF128
+	{"MSubF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyF128, ItyF128}},                         // IopMSubF128
+	{"NegMAddF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyF128, ItyF128}},                      // IopNegMAddF128
+	{"NegMSubF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyF128, ItyF128}},                      // IopNegMSubF128
+	{"NegF128", ItyF128, [4]IRType{ItyF128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopNegF128
+	{"AbsF128", ItyF128, [4]IRType{ItyF128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopAbsF128
+	{"SqrtF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                   // IopSqrtF128
+	{"I32StoF128", ItyF128, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI32StoF128
+	{"I64StoF128", ItyF128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI64StoF128
+	{"I32UtoF128", ItyF128, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI32UtoF128
+	{"I64UtoF128", ItyF128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI64UtoF128
+	{"F32toF128", ItyF128, [4]IRType{ItyF32, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopF32toF128
+	{"F64toF128", ItyF128, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopF64toF128
+	{"F128toI32S", ItyI32, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                  // IopF128toI32S
+	{"F128toI64S", ItyI64, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                  // IopF128toI64S
+	{"F128toI32U", ItyI32, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                  // IopF128toI32U
+	{"F128toI64U", ItyI64, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                  // IopF128toI64U
+	{"F128toI128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                 // IopF128toI128S
+	{"F128toF64", ItyF64, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                   // IopF128toF64
+	{"F128toF32", ItyF32, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                   // IopF128toF32
+	{"RndF128", ItyF128, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                    // IopRndF128
+	{"TruncF128toI32S", ItyF128, [4]IRType{ItyF128, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopTruncF128toI32S
+	{"TruncF128toI32U", ItyF128, [4]IRType{ItyF128, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopTruncF128toI32U
+	{"TruncF128toI64U", ItyF128, [4]IRType{ItyF128, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopTruncF128toI64U
+	{"TruncF128toI64S", ItyF128, [4]IRType{ItyF128, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopTruncF128toI64S
+	{"AtanF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                          // IopAtanF64
+	{"Yl2xF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                          // IopYl2xF64
+	{"Yl2xp1F64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                        // IopYl2xp1F64
+	{"PRemF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                          // IopPRemF64
+	{"PRemC3210F64", ItyI32, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                     // IopPRemC3210F64
+	{"PRem1F64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                         // IopPRem1F64
+	{"PRem1C3210F64", ItyI32, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                    // IopPRem1C3210F64
+	{"ScaleF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyINVALID}},                         // IopScaleF64
+	{"SinF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                       // IopSinF64
+	{"CosF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                       // IopCosF64
+	{"TanF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                       // IopTanF64
+	{"2xm1F64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                      // Iop2xm1F64
+	{"RoundF128toInt", ItyF128, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},             // IopRoundF128toInt
+	{"RoundF64toInt", ItyF64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                // IopRoundF64toInt
+	{"RoundF32toInt", ItyF32, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                // IopRoundF32toInt
+	{"s390_MAddF32", ItyF32, [4]IRType{ItyI32, ItyF32, ItyF32, ItyF32}},                         // IopMAddF32
+	{"s390_MSubF32", ItyF32, [4]IRType{ItyI32, ItyF32, ItyF32, ItyF32}},                         // IopMSubF32
+	{"MAddF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyF64}},                              // IopMAddF64
+	{"MSubF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyF64}},                              // IopMSubF64
+	{"MAddF64r32", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyF64}},                           // IopMAddF64r32
+	{"MSubF64r32", ItyF64, [4]IRType{ItyI32, ItyF64, ItyF64, ItyF64}},                           // IopMSubF64r32
+	{"RSqrtEst5GoodF64", ItyF64, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopRSqrtEst5GoodF64
+	{"RoundF64toF64_NEAREST", ItyF64, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},    // IopRoundF64toF64NEAREST
+	{"RoundF64toF64_NegINF", ItyF64, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},     // IopRoundF64toF64NegINF
+	{"RoundF64toF64_PosINF", ItyF64, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},     // IopRoundF64toF64PosINF
+	{"RoundF64toF64_ZERO", ItyF64, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},       // IopRoundF64toF64ZERO
+	{"TruncF64asF32", ItyF32, [4]IRType{ItyF64, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopTruncF64asF32
+	{"RoundF64toF32", ItyF64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                // IopRoundF64toF32
+	{"RecpExpF64", ItyF64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                   // IopRecpExpF64
+	{"RecpExpF32", ItyF32, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                   // IopRecpExpF32
+	{"MaxNumF64", ItyF64, [4]IRType{ItyF64, ItyF64, ItyINVALID, ItyINVALID}},                    // IopMaxNumF64
+	{"MinNumF64", ItyF64, [4]IRType{ItyF64, ItyF64, ItyINVALID, ItyINVALID}},                    // IopMinNumF64
+	{"MaxNumF32", ItyF32, [4]IRType{ItyF32, ItyF32, ItyINVALID, ItyINVALID}},                    // IopMaxNumF32
+	{"MinNumF32", ItyF32, [4]IRType{ItyF32, ItyF32, ItyINVALID, ItyINVALID}},                    // IopMinNumF32
+	{"F16toF64", ItyF64, [4]IRType{ItyF16, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopF16toF64
+	{"F64toF16", ItyF16, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                     // IopF64toF16
+	{"F16toF32", ItyF32, [4]IRType{ItyF16, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopF16toF32
+	{"F32toF16", ItyF16, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                     // IopF32toF16
+	{"QAdd32S", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopQAdd32S
+	{"QSub32S", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopQSub32S
+	{"Add16x2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopAdd16x2
+	{"Sub16x2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopSub16x2
+	{"QAdd16Sx2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopQAdd16Sx2
+	{"QAdd16Ux2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopQAdd16Ux2
+	{"QSub16Sx2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopQSub16Sx2
+	{"QSub16Ux2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopQSub16Ux2
+	{"HAdd16Ux2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopHAdd16Ux2
+	{"HAdd16Sx2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopHAdd16Sx2
+	{"HSub16Ux2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopHSub16Ux2
+	{"HSub16Sx2", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                    // IopHSub16Sx2
+	{"Add8x4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                       // IopAdd8x4
+	{"Sub8x4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                       // IopSub8x4
+	{"QAdd8Sx4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // IopQAdd8Sx4
+	{"QAdd8Ux4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // IopQAdd8Ux4
+	{"QSub8Sx4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // IopQSub8Sx4
+	{"QSub8Ux4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // IopQSub8Ux4
+	{"HAdd8Ux4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // IopHAdd8Ux4
+	{"HAdd8Sx4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // IopHAdd8Sx4
+	{"HSub8Ux4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // IopHSub8Ux4
+	{"HSub8Sx4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                     // IopHSub8Sx4
+	{"Sad8Ux4", ItyI32, [4]IRType{ItyI32, ItyI32, ItyINVALID, ItyINVALID}},                      // IopSad8Ux4
+	{"CmpNEZ16x2", ItyI32, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopCmpNEZ16x2
+	{"CmpNEZ8x4", ItyI32, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopCmpNEZ8x4
+	{"I32UtoFx2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopI32UtoFx2
+	{"I32StoFx2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopI32StoFx2
+	{"FtoI32Ux2_RZ", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopFtoI32Ux2RZ
+	{"FtoI32Sx2_RZ", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopFtoI32Sx2RZ
+	{"F32ToFixed32Ux2_RZ", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},            // IopF32ToFixed32Ux2RZ
+	{"F32ToFixed32Sx2_RZ", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},            // IopF32ToFixed32Sx2RZ
+	{"Fixed32UToF32x2_RN", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},            // IopFixed32UToF32x2RN
+	{"Fixed32SToF32x2_RN", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},            // IopFixed32SToF32x2RN
+	{"Max32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMax32Fx2
+	{"Min32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMin32Fx2
+	{"PwMax32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMax32Fx2
+	{"PwMin32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMin32Fx2
+	{"CmpEQ32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopCmpEQ32Fx2
+	{"CmpGT32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopCmpGT32Fx2
+	{"CmpGE32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopCmpGE32Fx2
+	{"RecipEst32Fx2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopRecipEst32Fx2
+	{"RecipStep32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},               // IopRecipStep32Fx2
+	{"RSqrtEst32Fx2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopRSqrtEst32Fx2
+	{"RSqrtStep32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},               // IopRSqrtStep32Fx2
+	{"Neg32Fx2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopNeg32Fx2
+	{"Abs32Fx2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopAbs32Fx2
+	{"CmpNEZ8x8", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopCmpNEZ8x8
+	{"CmpNEZ16x4", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopCmpNEZ16x4
+	{"CmpNEZ32x2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopCmpNEZ32x2
+	{"Add8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopAdd8x8
+	{"Add16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopAdd16x4
+	{"Add32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopAdd32x2
+	{"QAdd8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQAdd8Ux8
+	{"QAdd16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQAdd16Ux4
+	{"QAdd32Ux2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQAdd32Ux2
+	{"QAdd64Ux1", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQAdd64Ux1
+	{"QAdd8Sx8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQAdd8Sx8
+	{"QAdd16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQAdd16Sx4
+	{"QAdd32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQAdd32Sx2
+	{"QAdd64Sx1", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQAdd64Sx1
+	{"PwAdd8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopPwAdd8x8
+	{"PwAdd16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopPwAdd16x4
+	{"PwAdd32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopPwAdd32x2
+	{"PwMax8Sx8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopPwMax8Sx8
+	{"PwMax16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMax16Sx4
+	{"PwMax32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMax32Sx2
+	{"PwMax8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopPwMax8Ux8
+	{"PwMax16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMax16Ux4
+	{"PwMax32Ux2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMax32Ux2
+	{"PwMin8Sx8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopPwMin8Sx8
+	{"PwMin16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMin16Sx4
+	{"PwMin32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMin32Sx2
+	{"PwMin8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopPwMin8Ux8
+	{"PwMin16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMin16Ux4
+	{"PwMin32Ux2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwMin32Ux2
+	{"PwAddL8Ux8", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopPwAddL8Ux8
+	{"PwAddL16Ux4", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopPwAddL16Ux4
+	{"PwAddL32Ux2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopPwAddL32Ux2
+	{"PwAddL8Sx8", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopPwAddL8Sx8
+	{"PwAddL16Sx4", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopPwAddL16Sx4
+	{"PwAddL32Sx2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopPwAddL32Sx2
+	{"Sub8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopSub8x8
+	{"Sub16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopSub16x4
+	{"Sub32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopSub32x2
+	{"QSub8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQSub8Ux8
+	{"QSub16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQSub16Ux4
+	{"QSub32Ux2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQSub32Ux2
+	{"QSub64Ux1", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQSub64Ux1
+	{"QSub8Sx8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQSub8Sx8
+	{"QSub16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQSub16Sx4
+	{"QSub32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQSub32Sx2
+	{"QSub64Sx1", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopQSub64Sx1
+	{"Abs8x8", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopAbs8x8
+	{"Abs16x4", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopAbs16x4
+	{"Abs32x2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopAbs32x2
+	{"Mul8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopMul8x8
+	{"Mul16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopMul16x4
+	{"Mul32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopMul32x2
+	{"Mul32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMul32Fx2
+	{"MulHi16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopMulHi16Ux4
+	{"MulHi16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopMulHi16Sx4
+	{"PolynomialMul8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},             // IopPolynomialMul8x8
+	{"QDMulHi16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                 // IopQDMulHi16Sx4
+	{"QDMulHi32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                 // IopQDMulHi32Sx2
+	{"QRDMulHi16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                // IopQRDMulHi16Sx4
+	{"QRDMulHi32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                // IopQRDMulHi32Sx2
+	{"Avg8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopAvg8Ux8
+	{"Avg16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopAvg16Ux4
+	{"Max8Sx8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopMax8Sx8
+	{"Max16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMax16Sx4
+	{"Max32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMax32Sx2
+	{"Max8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopMax8Ux8
+	{"Max16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMax16Ux4
+	{"Max32Ux2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMax32Ux2
+	{"Min8Sx8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopMin8Sx8
+	{"Min16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMin16Sx4
+	{"Min32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMin32Sx2
+	{"Min8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopMin8Ux8
+	{"Min16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMin16Ux4
+	{"Min32Ux2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopMin32Ux2
+	{"CmpEQ8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopCmpEQ8x8
+	{"CmpEQ16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopCmpEQ16x4
+	{"CmpEQ32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopCmpEQ32x2
+	{"CmpGT8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopCmpGT8Ux8
+	{"CmpGT16Ux4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopCmpGT16Ux4
+	{"CmpGT32Ux2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopCmpGT32Ux2
+	{"CmpGT8Sx8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopCmpGT8Sx8
+	{"CmpGT16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopCmpGT16Sx4
+	{"CmpGT32Sx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopCmpGT32Sx2
+	{"Cnt8x8", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopCnt8x8
+	{"Clz8x8", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopClz8x8
+	{"Clz16x4", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopClz16x4
+	{"Clz32x2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopClz32x2
+	{"Cls8x8", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                   // IopCls8x8
+	{"Cls16x4", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopCls16x4
+	{"Cls32x2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopCls32x2
+	{"Clz64x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopClz64x2
+	{"Iop_Ctz8x16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopCtz8x16
+	{"Iop_Ctz16x8", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopCtz16x8
+	{"Iop_Ctz32x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopCtz32x4
+	{"Iop_Ctz64x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopCtz64x2
+	{"Shl8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopShl8x8
+	{"Shl16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopShl16x4
+	{"Shl32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopShl32x2
+	{"Shr8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopShr8x8
+	{"Shr16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopShr16x4
+	{"Shr32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopShr32x2
+	{"Sar8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopSar8x8
+	{"Sar16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopSar16x4
+	{"Sar32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopSar32x2
+	{"Sal8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                       // IopSal8x8
+	{"Sal16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopSal16x4
+	{"Sal32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopSal32x2
+	{"Sal64x1", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopSal64x1
+	{"ShlN8x8", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                       // IopShlN8x8
+	{"ShlN16x4", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                      // IopShlN16x4
+	{"ShlN32x2", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                      // IopShlN32x2
+	{"ShrN8x8", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                       // IopShrN8x8
+	{"ShrN16x4", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                      // IopShrN16x4
+	{"ShrN32x2", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                      // IopShrN32x2
+	{"SarN8x8", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                       // IopSarN8x8
+	{"SarN16x4", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                      // IopSarN16x4
+	{"SarN32x2", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                      // IopSarN32x2
+	{"QShl8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopQShl8x8
+	{"QShl16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQShl16x4
+	{"QShl32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQShl32x2
+	{"QShl64x1", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQShl64x1
+	{"QSal8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopQSal8x8
+	{"QSal16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQSal16x4
+	{"QSal32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQSal32x2
+	{"QSal64x1", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopQSal64x1
+	{"QShlNsatSU8x8", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                 // IopQShlNsatSU8x8
+	{"QShlNsatSU16x4", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatSU16x4
+	{"QShlNsatSU32x2", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatSU32x2
+	{"QShlNsatSU64x1", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatSU64x1
+	{"QShlNsatUU8x8", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                 // IopQShlNsatUU8x8
+	{"QShlNsatUU16x4", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatUU16x4
+	{"QShlNsatUU32x2", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatUU32x2
+	{"QShlNsatUU64x1", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatUU64x1
+	{"QShlNsatSS8x8", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                 // IopQShlNsatSS8x8
+	{"QShlNsatSS16x4", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatSS16x4
+	{"QShlNsatSS32x2", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatSS32x2
+	{"QShlNsatSS64x1", ItyI64, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                // IopQShlNsatSS64x1
+	{"QNarrowBin16Sto8Ux8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},          // IopQNarrowBin16Sto8Ux8
+	{"QNarrowBin16Sto8Sx8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},          // IopQNarrowBin16Sto8Sx8
+	{"QNarrowBin32Sto16Sx4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},         // IopQNarrowBin32Sto16Sx4
+	{"NarrowBin16to8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},             // IopNarrowBin16to8x8
+	{"NarrowBin32to16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},            // IopNarrowBin32to16x4
+	{"InterleaveHI8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},              // IopInterleaveHI8x8
+	{"InterleaveHI16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},             // IopInterleaveHI16x4
+	{"InterleaveHI32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},             // IopInterleaveHI32x2
+	{"InterleaveLO8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},              // IopInterleaveLO8x8
+	{"InterleaveLO16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},             // IopInterleaveLO16x4
+	{"InterleaveLO32x2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},             // IopInterleaveLO32x2
+	{"InterleaveOddLanes8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},        // IopInterleaveOddLanes8x8
+	{"InterleaveEvenLanes8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},       // IopInterleaveEvenLanes8x8
+	{"InterleaveOddLanes16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},       // IopInterleaveOddLanes16x4
+	{"InterleaveEvenLanes16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},      // IopInterleaveEvenLanes16x4
+	{"CatOddLanes8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},               // IopCatOddLanes8x8
+	{"CatOddLanes16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},              // IopCatOddLanes16x4
+	{"CatEvenLanes8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},              // IopCatEvenLanes8x8
+	{"CatEvenLanes16x4", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},             // IopCatEvenLanes16x4
+	{"GetElem8x8", ItyI8, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                     // IopGetElem8x8
+	{"GetElem16x4", ItyI16, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                   // IopGetElem16x4
+	{"GetElem32x2", ItyI32, [4]IRType{ItyI64, ItyI8, ItyINVALID, ItyINVALID}},                   // IopGetElem32x2
+	{"SetElem8x8", ItyI64, [4]IRType{ItyI64, ItyI8, ItyI8, ItyINVALID}},                         // IopSetElem8x8
+	{"SetElem16x4", ItyI64, [4]IRType{ItyI64, ItyI8, ItyI16, ItyINVALID}},                       // IopSetElem16x4
+	{"SetElem32x2", ItyI64, [4]IRType{ItyI64, ItyI8, ItyI32, ItyINVALID}},                       // IopSetElem32x2
+	{"Dup8x8", ItyI64, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                    // IopDup8x8
+	{"Dup16x4", ItyI64, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopDup16x4
+	{"Dup32x2", ItyI64, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopDup32x2
+	{"Slice64", ItyI64, [4]IRType{ItyI64, ItyI64, ItyI8, ItyINVALID}},                           // IopSlice64
+	{"Reverse8sIn16_x4", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReverse8sIn16x4
+	{"Reverse8sIn32_x2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReverse8sIn32x2
+	{"Reverse16sIn32_x2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopReverse16sIn32x2
+	{"Reverse8sIn64_x1", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReverse8sIn64x1
+	{"Reverse16sIn64_x1", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopReverse16sIn64x1
+	{"Reverse32sIn64_x1", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopReverse32sIn64x1
+	{"Perm8x8", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                      // IopPerm8x8
+	{"GetMSBs8x8", ItyI8, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopGetMSBs8x8
+	{"RecipEst32Ux2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopRecipEst32Ux2
+	{"RSqrtEst32Ux2", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopRSqrtEst32Ux2
+	{"AddD64", ItyD64, [4]IRType{ItyI32, ItyD64, ItyD64, ItyINVALID}},                           // IopAddD64
+	{"SubD64", ItyD64, [4]IRType{ItyI32, ItyD64, ItyD64, ItyINVALID}},                           // IopSubD64
+	{"MulD64", ItyD64, [4]IRType{ItyI32, ItyD64, ItyD64, ItyINVALID}},                           // IopMulD64
+	{"DivD64", ItyD64, [4]IRType{ItyI32, ItyD64, ItyD64, ItyINVALID}},                           // IopDivD64
+	{"AddD128", ItyD128, [4]IRType{ItyI32, ItyD128, ItyD128, ItyINVALID}},                       // IopAddD128
+	{"SubD128", ItyD128, [4]IRType{ItyI32, ItyD128, ItyD128, ItyINVALID}},                       // IopSubD128
+	{"MulD128", ItyD128, [4]IRType{ItyI32, ItyD128, ItyD128, ItyINVALID}},                       // IopMulD128
+	{"DivD128", ItyD128, [4]IRType{ItyI32, ItyD128, ItyD128, ItyINVALID}},                       // IopDivD128
+	{"ShlD64", ItyD64, [4]IRType{ItyD64, ItyI8, ItyINVALID, ItyINVALID}},                        // IopShlD64
+	{"ShrD64", ItyD64, [4]IRType{ItyD64, ItyI8, ItyINVALID, ItyINVALID}},                        // IopShrD64
+	{"ShlD128", ItyD128, [4]IRType{ItyD128, ItyI8, ItyINVALID, ItyINVALID}},                     // IopShlD128
+	{"ShrD128", ItyD128, [4]IRType{ItyD128, ItyI8, ItyINVALID, ItyINVALID}},                     // IopShrD128
+	{"D32toD64", ItyD64, [4]IRType{ItyD32, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopD32toD64
+	{"D64toD128", ItyD128, [4]IRType{ItyD64, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopD64toD128
+	{"I32StoD128", ItyD128, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI32StoD128
+	{"I32UtoD128", ItyD128, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI32UtoD128
+	{"I64StoD128", ItyD128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI64StoD128
+	{"I64UtoD128", ItyD128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI64UtoD128
+	{"D64toD32", ItyD32, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                     // IopD64toD32
+	{"D128toD64", ItyD64, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},                   // IopD128toD64
+	{"I32StoD64", ItyD64, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopI32StoD64
+	{"I32UtoD64", ItyD64, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopI32UtoD64
+	{"I64StoD64", ItyD64, [4]IRType{ItyI32, ItyI64, ItyINVALID, ItyINVALID}},                    // IopI64StoD64
+	{"I64UtoD64", ItyD64, [4]IRType{ItyI32, ItyI64, ItyINVALID, ItyINVALID}},                    // IopI64UtoD64
+	{"D64toI32S", ItyI32, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                    // IopD64toI32S
+	{"D64toI32U", ItyI32, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                    // IopD64toI32U
+	{"D64toI64S", ItyI64, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                    // IopD64toI64S
+	{"D64toI64U", ItyI64, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                    // IopD64toI64U
+	{"D128toI32S", ItyI32, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},                  // IopD128toI32S
+	{"D128toI32U", ItyI32, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},                  // IopD128toI32U
+	{"D128toI64S", ItyI64, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},                  // IopD128toI64S
+	{"D128toI64U", ItyI64, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},                  // IopD128toI64U
+	{"F32toD32", ItyD32, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                     // IopF32toD32
+	{"F32toD64", ItyD64, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                     // IopF32toD64
+	{"F32toD128", ItyD128, [4]IRType{ItyI32, ItyF32, ItyINVALID, ItyINVALID}},                   // IopF32toD128
+	{"F64toD32", ItyD32, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                     // IopF64toD32
+	{"F64toD64", ItyD64, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                     // IopF64toD64
+	{"F64toD128", ItyD128, [4]IRType{ItyI32, ItyF64, ItyINVALID, ItyINVALID}},                   // IopF64toD128
+	{"F128toD32", ItyD32, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                   // IopF128toD32
+	{"F128toD64", ItyD64, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                   // IopF128toD64
+	{"F128toD128", ItyD128, [4]IRType{ItyI32, ItyF128, ItyINVALID, ItyINVALID}},                 // IopF128toD128
+	{"D32toF32", ItyF32, [4]IRType{ItyI32, ItyD32, ItyINVALID, ItyINVALID}},                     // IopD32toF32
+	{"D32toF64", ItyF64, [4]IRType{ItyI32, ItyD32, ItyINVALID, ItyINVALID}},                     // IopD32toF64
+	{"D32toF128", ItyF128, [4]IRType{ItyI32, ItyD32, ItyINVALID, ItyINVALID}},                   // IopD32toF128
+	{"D64toF32", ItyF32, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                     // IopD64toF32
+	{"D64toF64", ItyF64, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                     // IopD64toF64
+	{"D64toF128", ItyF128, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                   // IopD64toF128
+	{"D128toF32", ItyF32, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},                   // IopD128toF32
+	{"D128toF64", ItyF64, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},                   // IopD128toF64
+	{"D128toF128", ItyF128, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},                 // IopD128toF128
+	{"RoundD64toInt", ItyD64, [4]IRType{ItyI32, ItyD64, ItyINVALID, ItyINVALID}},                // IopRoundD64toInt
+	{"RoundD128toInt", ItyD128, [4]IRType{ItyI32, ItyD128, ItyINVALID, ItyINVALID}},             // IopRoundD128toInt
+	{"CmpD64", ItyI32, [4]IRType{ItyD64, ItyD64, ItyINVALID, ItyINVALID}},                       // IopCmpD64
+	{"CmpD128", ItyI32, [4]IRType{ItyD128, ItyD128, ItyINVALID, ItyINVALID}},                    // IopCmpD128
+	{"CmpExpD64", ItyI32, [4]IRType{ItyD64, ItyD64, ItyINVALID, ItyINVALID}},                    // IopCmpExpD64
+	{"CmpExpD128", ItyI32, [4]IRType{ItyD128, ItyD128, ItyINVALID, ItyINVALID}},                 // IopCmpExpD128
+	{"QuantizeD64", ItyD64, [4]IRType{ItyI32, ItyD64, ItyD64, ItyINVALID}},                      // IopQuantizeD64
+	{"QuantizeD128", ItyD128, [4]IRType{ItyI32, ItyD128, ItyD128, ItyINVALID}},                  // IopQuantizeD128
+	{"SignificanceRoundD64", ItyD64, [4]IRType{ItyI32, ItyI8, ItyD64, ItyINVALID}},              // IopSignificanceRoundD64
+	{"SignificanceRoundD128", ItyD128, [4]IRType{ItyI32, ItyI8, ItyD128, ItyINVALID}},           // IopSignificanceRoundD128
+	{"ExtractExpD64", ItyI64, [4]IRType{ItyD64, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopExtractExpD64
+	{"ExtractExpD128", ItyI64, [4]IRType{ItyD128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopExtractExpD128
+	{"ExtractSigD64", ItyI64, [4]IRType{ItyD64, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopExtractSigD64
+	{"ExtractSigD128", ItyI64, [4]IRType{ItyD128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopExtractSigD128
+	{"InsertExpD64", ItyD64, [4]IRType{ItyI64, ItyD64, ItyINVALID, ItyINVALID}},                 // IopInsertExpD64
+	{"InsertExpD128", ItyD128, [4]IRType{ItyI64, ItyD128, ItyINVALID, ItyINVALID}},              // IopInsertExpD128
+	{"D64HLtoD128", ItyD128, [4]IRType{ItyD64, ItyD64, ItyINVALID, ItyINVALID}},                 // IopD64HLtoD128
+	{"D128HItoD64", ItyD64, [4]IRType{ItyD128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopD128HItoD64
+	{"D128LOtoD64", ItyD64, [4]IRType{ItyD128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopD128LOtoD64
+	{"DPBtoBCD", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopDPBtoBCD
+	{"BCDtoDPB", ItyI64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopBCDtoDPB
+	{"BCDAdd", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                    // IopBCDAdd
+	{"BCDSub", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                    // IopBCDSub
+	{"bcdcfsq.", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopI128StoBCD128
+	{"bcdctsq.", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopBCD128toI128S
+	{"ReinterpI64asD64", ItyD64, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReinterpI64asD64
+	{"ReinterpD64asI64", ItyI64, [4]IRType{ItyD64, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopReinterpD64asI64
+	{"Add32Fx4", ItyV128, [4]IRType{ItyI32, ItyV128, ItyV128, ItyINVALID}},                      // IopAdd32Fx4
+	{"Sub32Fx4", ItyV128, [4]IRType{ItyI32, ItyV128, ItyV128, ItyINVALID}},                      // IopSub32Fx4
+	{"Mul32Fx4", ItyV128, [4]IRType{ItyI32, ItyV128, ItyV128, ItyINVALID}},                      // IopMul32Fx4
+	{"Div32Fx4", ItyV128, [4]IRType{ItyI32, ItyV128, ItyV128, ItyINVALID}},                      // IopDiv32Fx4
+	{"Max32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax32Fx4
+	{"Min32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin32Fx4
+	{"Add32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopAdd32Fx2
+	{"Sub32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                     // IopSub32Fx2
+	{"CmpEQ32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpEQ32Fx4
+	{"CmpLT32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpLT32Fx4
+	{"CmpLE32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpLE32Fx4
+	{"CmpUN32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpUN32Fx4
+	{"CmpGT32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT32Fx4
+	{"CmpGE32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGE32Fx4
+	{"PwMax32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopPwMax32Fx4
+	{"PwMin32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopPwMin32Fx4
+	{"Abs32Fx4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopAbs32Fx4
+	{"Neg32Fx4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopNeg32Fx4
+	{"Sqrt32Fx4", ItyV128, [4]IRType{ItyI32, ItyV128, ItyINVALID, ItyINVALID}},                  // IopSqrt32Fx4
+	{"RecipEst32Fx4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRecipEst32Fx4
+	{"RecipStep32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},            // IopRecipStep32Fx4
+	{"RSqrtEst32Fx4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRSqrtEst32Fx4
+	{"RSqrtStep32Fx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},            // IopRSqrtStep32Fx4
+	{"I32UtoFx4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI32UtoFx4
+	{"I32StoFx4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopI32StoFx4
+	{"FtoI32Ux4_RZ", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},           // IopFtoI32Ux4RZ
+	{"FtoI32Sx4_RZ", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},           // IopFtoI32Sx4RZ
+	{"QFtoI32Ux4_RZ", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopQFtoI32Ux4RZ
+	{"QFtoI32Sx4_RZ", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopQFtoI32Sx4RZ
+	{"RoundF32x4_RM", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRoundF32x4RM
+	{"RoundF32x4_RP", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRoundF32x4RP
+	{"RoundF32x4_RN", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRoundF32x4RN
+	{"RoundF32x4_RZ", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRoundF32x4RZ
+	{"F32ToFixed32Ux4_RZ", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},          // IopF32ToFixed32Ux4RZ
+	{"F32ToFixed32Sx4_RZ", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},          // IopF32ToFixed32Sx4RZ
+	{"Fixed32UToF32x4_RN", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},          // IopFixed32UToF32x4RN
+	{"Fixed32SToF32x4_RN", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},          // IopFixed32SToF32x4RN
+	{"F32toF16x4", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopF32toF16x4
+	{"F16toF32x4", ItyV128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopF16toF32x4
+	{"F64toF16x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopF64toF16x2
+	{"F16toF64x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopF16toF64x2
+	{"Add32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopAdd32F0x4
+	{"Sub32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopSub32F0x4
+	{"Mul32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopMul32F0x4
+	{"Div32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopDiv32F0x4
+	{"Max32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopMax32F0x4
+	{"Min32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopMin32F0x4
+	{"CmpEQ32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCmpEQ32F0x4
+	{"CmpLT32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCmpLT32F0x4
+	{"CmpLE32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCmpLE32F0x4
+	{"CmpUN32F0x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCmpUN32F0x4
+	{"RecipEst32F0x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopRecipEst32F0x4
+	{"Sqrt32F0x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopSqrt32F0x4
+	{"RSqrtEst32F0x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopRSqrtEst32F0x4
+	{"Add64Fx2", ItyV128, [4]IRType{ItyI32, ItyV128, ItyV128, ItyINVALID}},                      // IopAdd64Fx2
+	{"Sub64Fx2", ItyV128, [4]IRType{ItyI32, ItyV128, ItyV128, ItyINVALID}},                      // IopSub64Fx2
+	{"Mul64Fx2", ItyV128, [4]IRType{ItyI32, ItyV128, ItyV128, ItyINVALID}},                      // IopMul64Fx2
+	{"Div64Fx2", ItyV128, [4]IRType{ItyI32, ItyV128, ItyV128, ItyINVALID}},                      // IopDiv64Fx2
+	{"Max64Fx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax64Fx2
+	{"Min64Fx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin64Fx2
+	{"CmpEQ64Fx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpEQ64Fx2
+	{"CmpLT64Fx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpLT64Fx2
+	{"CmpLE64Fx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpLE64Fx2
+	{"CmpUN64Fx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpUN64Fx2
+	{"Abs64Fx2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopAbs64Fx2
+	{"Neg64Fx2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},               // IopNeg64Fx2
+	{"Sqrt64Fx2", ItyV128, [4]IRType{ItyI32, ItyV128, ItyINVALID, ItyINVALID}},                  // IopSqrt64Fx2
+	{"RecipEst64Fx2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRecipEst64Fx2
+	{"RecipStep64Fx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},            // IopRecipStep64Fx2
+	{"RSqrtEst64Fx2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRSqrtEst64Fx2
+	{"RSqrtStep64Fx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},            // IopRSqrtStep64Fx2
+	{"Add64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopAdd64F0x2
+	{"Sub64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopSub64F0x2
+	{"Mul64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopMul64F0x2
+	{"Div64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopDiv64F0x2
+	{"Max64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopMax64F0x2
+	{"Min64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopMin64F0x2
+	{"CmpEQ64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCmpEQ64F0x2
+	{"CmpLT64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCmpLT64F0x2
+	{"CmpLE64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCmpLE64F0x2
+	{"CmpUN64F0x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCmpUN64F0x2
+	{"Sqrt64F0x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopSqrt64F0x2
+	{"V128to64", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopV128to64
+	{"V128HIto64", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopV128HIto64
+	{"64HLtoV128", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                  // Iop64HLtoV128
+	{"64UtoV128", ItyV128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},               // Iop64UtoV128
+	{"SetV128lo64", ItyV128, [4]IRType{ItyV128, ItyI64, ItyINVALID, ItyINVALID}},                // IopSetV128lo64
+	{"ZeroHI64ofV128", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopZeroHI64ofV128
+	{"ZeroHI96ofV128", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopZeroHI96ofV128
+	{"ZeroHI112ofV128", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopZeroHI112ofV128
+	{"ZeroHI120ofV128", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopZeroHI120ofV128
+	{"32UtoV128", ItyV128, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},               // Iop32UtoV128
+	{"V128to32", ItyI32, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopV128to32
+	{"SetV128lo32", ItyV128, [4]IRType{ItyV128, ItyI32, ItyINVALID, ItyINVALID}},                // IopSetV128lo32
+	{"NotV128", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopNotV128
+	{"AndV128", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopAndV128
+	{"OrV128", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                    // IopOrV128
+	{"XorV128", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopXorV128
+	{"ShlV128", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                     // IopShlV128
+	{"ShrV128", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                     // IopShrV128
+	{"SarV128", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                     // IopSarV128
+	{"CmpNEZ8x16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopCmpNEZ8x16
+	{"CmpNEZ16x8", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopCmpNEZ16x8
+	{"CmpNEZ32x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopCmpNEZ32x4
+	{"CmpNEZ64x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopCmpNEZ64x2
+	{"CmpNEZ128x1", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopCmpNEZ128x1
+	{"Add8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopAdd8x16
+	{"Add16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopAdd16x8
+	{"Add32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopAdd32x4
+	{"Add64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopAdd64x2
+	{"Add128x1", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAdd128x1
+	{"QAdd8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQAdd8Ux16
+	{"QAdd16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQAdd16Ux8
+	{"QAdd32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQAdd32Ux4
+	{"QAdd64Ux2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQAdd64Ux2
+	{"QAdd8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQAdd8Sx16
+	{"QAdd16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQAdd16Sx8
+	{"QAdd32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQAdd32Sx4
+	{"QAdd64Sx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQAdd64Sx2
+	{"QAddExtUSsatSS8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},        // IopQAddExtUSsatSS8x16
+	{"QAddExtUSsatSS16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},        // IopQAddExtUSsatSS16x8
+	{"QAddExtUSsatSS32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},        // IopQAddExtUSsatSS32x4
+	{"QAddExtUSsatSS64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},        // IopQAddExtUSsatSS64x2
+	{"QAddExtSUsatUU8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},        // IopQAddExtSUsatUU8x16
+	{"QAddExtSUsatUU16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},        // IopQAddExtSUsatUU16x8
+	{"QAddExtSUsatUU32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},        // IopQAddExtSUsatUU32x4
+	{"QAddExtSUsatUU64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},        // IopQAddExtSUsatUU64x2
+	{"Sub8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSub8x16
+	{"Sub16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSub16x8
+	{"Sub32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSub32x4
+	{"Sub64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSub64x2
+	{"Sub128x1", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopSub128x1
+	{"QSub8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQSub8Ux16
+	{"QSub16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQSub16Ux8
+	{"QSub32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQSub32Ux4
+	{"QSub64Ux2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQSub64Ux2
+	{"QSub8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQSub8Sx16
+	{"QSub16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQSub16Sx8
+	{"QSub32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQSub32Sx4
+	{"QSub64Sx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopQSub64Sx2
+	{"Mul8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopMul8x16
+	{"Mul16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopMul16x8
+	{"Mul32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopMul32x4
+	{"MulHi8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopMulHi8Ux16
+	{"MulHi16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopMulHi16Ux8
+	{"MulHi32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopMulHi32Ux4
+	{"MulHi8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopMulHi8Sx16
+	{"MulHi16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopMulHi16Sx8
+	{"MulHi32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopMulHi32Sx4
+	{"MullEven8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopMullEven8Ux16
+	{"MullEven16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopMullEven16Ux8
+	{"MullEven32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopMullEven32Ux4
+	{"MullEven8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopMullEven8Sx16
+	{"MullEven16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopMullEven16Sx8
+	{"MullEven32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopMullEven32Sx4
+	{"Mull8Ux8", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopMull8Ux8
+	{"Mull8Sx8", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                    // IopMull8Sx8
+	{"Mull16Ux4", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopMull16Ux4
+	{"Mull16Sx4", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopMull16Sx4
+	{"Mull32Ux2", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopMull32Ux2
+	{"Mull32Sx2", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopMull32Sx2
+	{"QDMull16Sx4", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                 // IopQDMull16Sx4
+	{"QDMull32Sx2", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                 // IopQDMull32Sx2
+	{"QDMulHi16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQDMulHi16Sx8
+	{"QDMulHi32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQDMulHi32Sx4
+	{"QRDMulHi16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQRDMulHi16Sx8
+	{"QRDMulHi32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQRDMulHi32Sx4
+	{"PolynomialMul8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},         // IopPolynomialMul8x16
+	{"PolynomialMull8x8", ItyV128, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},           // IopPolynomialMull8x8
+	{"PolynomialMulAdd8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopPolynomialMulAdd8x16
+	{"PolynomialMulAdd16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopPolynomialMulAdd16x8
+	{"PolynomialMulAdd32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopPolynomialMulAdd32x4
+	{"PolynomialMulAdd64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopPolynomialMulAdd64x2
+	{"PwAdd8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopPwAdd8x16
+	{"PwAdd16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopPwAdd16x8
+	{"PwAdd32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopPwAdd32x4
+	{"PwAdd32Fx2", ItyI64, [4]IRType{ItyI64, ItyI64, ItyINVALID, ItyINVALID}},                   // IopPwAdd32Fx2
+	{"PwAddL8Ux16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopPwAddL8Ux16
+	{"PwAddL16Ux8", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopPwAddL16Ux8
+	{"PwAddL32Ux4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopPwAddL32Ux4
+	{"PwAddL64Ux2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopPwAddL64Ux2
+	{"PwAddL8Sx16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopPwAddL8Sx16
+	{"PwAddL16Sx8", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopPwAddL16Sx8
+	{"PwAddL32Sx4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopPwAddL32Sx4
+	{"BitMatrixTranspose64x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}}, // IopPwBitMtxXpose64x2
+	{"Abs8x16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopAbs8x16
+	{"Abs16x8", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopAbs16x8
+	{"Abs32x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopAbs32x4
+	{"Abs64x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopAbs64x2
+	{"Avg8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAvg8Ux16
+	{"Avg16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAvg16Ux8
+	{"Avg32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAvg32Ux4
+	{"Avg64Ux2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAvg64Ux2
+	{"Avg8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAvg8Sx16
+	{"Avg16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAvg16Sx8
+	{"Avg32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAvg32Sx4
+	{"Avg64Sx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopAvg64Sx2
+	{"Max8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax8Sx16
+	{"Max16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax16Sx8
+	{"Max32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax32Sx4
+	{"Max64Sx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax64Sx2
+	{"Max8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax8Ux16
+	{"Max16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax16Ux8
+	{"Max32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax32Ux4
+	{"Max64Ux2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMax64Ux2
+	{"Min8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin8Sx16
+	{"Min16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin16Sx8
+	{"Min32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin32Sx4
+	{"Min64Sx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin64Sx2
+	{"Min8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin8Ux16
+	{"Min16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin16Ux8
+	{"Min32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin32Ux4
+	{"Min64Ux2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopMin64Ux2
+	{"CmpEQ8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopCmpEQ8x16
+	{"CmpEQ16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopCmpEQ16x8
+	{"CmpEQ32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopCmpEQ32x4
+	{"CmpEQ64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                 // IopCmpEQ64x2
+	{"CmpGT8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT8Sx16
+	{"CmpGT16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT16Sx8
+	{"CmpGT32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT32Sx4
+	{"CmpGT64Sx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT64Sx2
+	{"CmpGT8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT8Ux16
+	{"CmpGT16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT16Ux8
+	{"CmpGT32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT32Ux4
+	{"CmpGT64Ux2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCmpGT64Ux2
+	{"Cnt8x16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopCnt8x16
+	{"Clz8x16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopClz8x16
+	{"Clz16x8", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopClz16x8
+	{"Clz32x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopClz32x4
+	{"Cls8x16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopCls8x16
+	{"Cls16x8", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopCls16x8
+	{"Cls32x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopCls32x4
+	{"ShlN8x16", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShlN8x16
+	{"ShlN16x8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShlN16x8
+	{"ShlN32x4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShlN32x4
+	{"ShlN64x2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShlN64x2
+	{"ShrN8x16", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShrN8x16
+	{"ShrN16x8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShrN16x8
+	{"ShrN32x4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShrN32x4
+	{"ShrN64x2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShrN64x2
+	{"SarN8x16", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopSarN8x16
+	{"SarN16x8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopSarN16x8
+	{"SarN32x4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopSarN32x4
+	{"SarN64x2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                    // IopSarN64x2
+	{"Shl8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopShl8x16
+	{"Shl16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopShl16x8
+	{"Shl32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopShl32x4
+	{"Shl64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopShl64x2
+	{"Shr8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopShr8x16
+	{"Shr16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopShr16x8
+	{"Shr32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopShr32x4
+	{"Shr64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopShr64x2
+	{"Sar8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSar8x16
+	{"Sar16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSar16x8
+	{"Sar32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSar32x4
+	{"Sar64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSar64x2
+	{"Sal8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSal8x16
+	{"Sal16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSal16x8
+	{"Sal32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSal32x4
+	{"Sal64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSal64x2
+	{"Rol8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopRol8x16
+	{"Rol16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopRol16x8
+	{"Rol32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopRol32x4
+	{"Rol64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopRol64x2
+	{"QShl8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopQShl8x16
+	{"QShl16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopQShl16x8
+	{"QShl32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopQShl32x4
+	{"QShl64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopQShl64x2
+	{"QSal8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopQSal8x16
+	{"QSal16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopQSal16x8
+	{"QSal32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopQSal32x4
+	{"QSal64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopQSal64x2
+	{"QShlNsatSU8x16", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatSU8x16
+	{"QShlNsatSU16x8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatSU16x8
+	{"QShlNsatSU32x4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatSU32x4
+	{"QShlNsatSU64x2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatSU64x2
+	{"QShlNsatUU8x16", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatUU8x16
+	{"QShlNsatUU16x8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatUU16x8
+	{"QShlNsatUU32x4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatUU32x4
+	{"QShlNsatUU64x2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatUU64x2
+	{"QShlNsatSS8x16", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatSS8x16
+	{"QShlNsatSS16x8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatSS16x8
+	{"QShlNsatSS32x4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatSS32x4
+	{"QShlNsatSS64x2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},              // IopQShlNsatSS64x2
+	{"QandUQsh8x16", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQandUQsh8x16
+	{"QandUQsh16x8", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQandUQsh16x8
+	{"QandUQsh32x4", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQandUQsh32x4
+	{"QandUQsh64x2", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQandUQsh64x2
+	{"QandSQsh8x16", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQandSQsh8x16
+	{"QandSQsh16x8", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQandSQsh16x8
+	{"QandSQsh32x4", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQandSQsh32x4
+	{"QandSQsh64x2", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopQandSQsh64x2
+	{"QandUQRsh8x16", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQandUQRsh8x16
+	{"QandUQRsh16x8", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQandUQRsh16x8
+	{"QandUQRsh32x4", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQandUQRsh32x4
+	{"QandUQRsh64x2", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQandUQRsh64x2
+	{"QandSQRsh8x16", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQandSQRsh8x16
+	{"QandSQRsh16x8", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQandSQRsh16x8
+	{"QandSQRsh32x4", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQandSQRsh32x4
+	{"QandSQRsh64x2", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},             // IopQandSQRsh64x2
+	{"Sh8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSh8Sx16
+	{"Sh16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSh16Sx8
+	{"Sh32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSh32Sx4
+	{"Sh64Sx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSh64Sx2
+	{"Sh8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSh8Ux16
+	{"Sh16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSh16Ux8
+	{"Sh32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSh32Ux4
+	{"Sh64Ux2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                   // IopSh64Ux2
+	{"Rsh8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopRsh8Sx16
+	{"Rsh16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopRsh16Sx8
+	{"Rsh32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopRsh32Sx4
+	{"Rsh64Sx2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopRsh64Sx2
+	{"Rsh8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopRsh8Ux16
+	{"Rsh16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopRsh16Ux8
+	{"Rsh32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopRsh32Ux4
+	{"Rsh64Ux2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopRsh64Ux2
+	{"QandQShrNnarrow16Uto8Ux8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},    // IopQandQShrNnarrow16Uto8Ux8
+	{"QandQShrNnarrow32Uto16Ux4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQShrNnarrow32Uto16Ux4
+	{"QandQShrNnarrow64Uto32Ux2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQShrNnarrow64Uto32Ux2
+	{"QandQSarNnarrow16Sto8Sx8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},    // IopQandQSarNnarrow16Sto8Sx8
+	{"QandQSarNnarrow32Sto16Sx4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQSarNnarrow32Sto16Sx4
+	{"QandQSarNnarrow64Sto32Sx2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQSarNnarrow64Sto32Sx2
+	{"QandQSarNnarrow16Sto8Ux8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},    // IopQandQSarNnarrow16Sto8Ux8
+	{"QandQSarNnarrow32Sto16Ux4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQSarNnarrow32Sto16Ux4
+	{"QandQSarNnarrow64Sto32Ux2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQSarNnarrow64Sto32Ux2
+	{"QandQRShrNnarrow16Uto8Ux8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQRShrNnarrow16Uto8Ux8
+	{"QandQRShrNnarrow32Uto16Ux4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},  // IopQandQRShrNnarrow32Uto16Ux4
+	{"QandQRShrNnarrow64Uto32Ux2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},  // IopQandQRShrNnarrow64Uto32Ux2
+	{"QandQRSarNnarrow16Sto8Sx8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQRSarNnarrow16Sto8Sx8
+	{"QandQRSarNnarrow32Sto16Sx4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},  // IopQandQRSarNnarrow32Sto16Sx4
+	{"QandQRSarNnarrow64Sto32Sx2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},  // IopQandQRSarNnarrow64Sto32Sx2
+	{"QandQRSarNnarrow16Sto8Ux8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},   // IopQandQRSarNnarrow16Sto8Ux8
+	{"QandQRSarNnarrow32Sto16Ux4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},  // IopQandQRSarNnarrow32Sto16Ux4
+	{"QandQRSarNnarrow64Sto32Ux2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},  // IopQandQRSarNnarrow64Sto32Ux2
+	{"QNarrowBin16Sto8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopQNarrowBin16Sto8Ux16
+	{"QNarrowBin32Sto16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopQNarrowBin32Sto16Ux8
+	{"QNarrowBin16Sto8Sx16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopQNarrowBin16Sto8Sx16
+	{"QNarrowBin32Sto16Sx8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopQNarrowBin32Sto16Sx8
+	{"QNarrowBin16Uto8Ux16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopQNarrowBin16Uto8Ux16
+	{"QNarrowBin32Uto16Ux8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopQNarrowBin32Uto16Ux8
+	{"NarrowBin16to8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},         // IopNarrowBin16to8x16
+	{"NarrowBin32to16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},         // IopNarrowBin32to16x8
+	{"QNarrowBin64Sto32Sx4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopQNarrowBin64Sto32Sx4
+	{"QNarrowBin64Uto32Ux4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},      // IopQNarrowBin64Uto32Ux4
+	{"NarrowBin64to32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},         // IopNarrowBin64to32x4
+	{"NarrowUn16to8x8", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},         // IopNarrowUn16to8x8
+	{"NarrowUn32to16x4", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopNarrowUn32to16x4
+	{"NarrowUn64to32x2", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},        // IopNarrowUn64to32x2
+	{"QNarrowUn16Sto8Sx8", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},      // IopQNarrowUn16Sto8Sx8
+	{"QNarrowUn32Sto16Sx4", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},     // IopQNarrowUn32Sto16Sx4
+	{"QNarrowUn64Sto32Sx2", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},     // IopQNarrowUn64Sto32Sx2
+	{"QNarrowUn16Sto8Ux8", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},      // IopQNarrowUn16Sto8Ux8
+	{"QNarrowUn32Sto16Ux4", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},     // IopQNarrowUn32Sto16Ux4
+	{"QNarrowUn64Sto32Ux2", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},     // IopQNarrowUn64Sto32Ux2
+	{"QNarrowUn16Uto8Ux8", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},      // IopQNarrowUn16Uto8Ux8
+	{"QNarrowUn32Uto16Ux4", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},     // IopQNarrowUn32Uto16Ux4
+	{"QNarrowUn64Uto32Ux2", ItyI64, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},     // IopQNarrowUn64Uto32Ux2
+	{"Widen8Uto16x8", ItyV128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},           // IopWiden8Uto16x8
+	{"Widen16Uto32x4", ItyV128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopWiden16Uto32x4
+	{"Widen32Uto64x2", ItyV128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopWiden32Uto64x2
+	{"Widen8Sto16x8", ItyV128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},           // IopWiden8Sto16x8
+	{"Widen16Sto32x4", ItyV128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopWiden16Sto32x4
+	{"Widen32Sto64x2", ItyV128, [4]IRType{ItyI64, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopWiden32Sto64x2
+	{"InterleaveHI8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopInterleaveHI8x16
+	{"InterleaveHI16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopInterleaveHI16x8
+	{"InterleaveHI32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopInterleaveHI32x4
+	{"InterleaveHI64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopInterleaveHI64x2
+	{"InterleaveLO8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopInterleaveLO8x16
+	{"InterleaveLO16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopInterleaveLO16x8
+	{"InterleaveLO32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopInterleaveLO32x4
+	{"InterleaveLO64x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopInterleaveLO64x2
+	{"InterleaveOddLanes8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},    // IopInterleaveOddLanes8x16
+	{"InterleaveEvenLanes8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},   // IopInterleaveEvenLanes8x16
+	{"InterleaveOddLanes16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},    // IopInterleaveOddLanes16x8
+	{"InterleaveEvenLanes16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},   // IopInterleaveEvenLanes16x8
+	{"InterleaveOddLanes32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},    // IopInterleaveOddLanes32x4
+	{"InterleaveEvenLanes32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},   // IopInterleaveEvenLanes32x4
+	{"CatOddLanes8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},           // IopCatOddLanes8x16
+	{"CatOddLanes16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},           // IopCatOddLanes16x8
+	{"CatOddLanes32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},           // IopCatOddLanes32x4
+	{"CatEvenLanes8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopCatEvenLanes8x16
+	{"CatEvenLanes16x8", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopCatEvenLanes16x8
+	{"CatEvenLanes32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},          // IopCatEvenLanes32x4
+	{"GetElem8x16", ItyI8, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                   // IopGetElem8x16
+	{"GetElem16x8", ItyI16, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                  // IopGetElem16x8
+	{"GetElem32x4", ItyI32, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                  // IopGetElem32x4
+	{"GetElem64x2", ItyI64, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                  // IopGetElem64x2
+	{"SetElem8x16", ItyV128, [4]IRType{ItyV128, ItyI8, ItyI8, ItyINVALID}},                      // IopSetElem8x16
+	{"SetElem16x8", ItyV128, [4]IRType{ItyV128, ItyI8, ItyI16, ItyINVALID}},                     // IopSetElem16x8
+	{"SetElem32x4", ItyV128, [4]IRType{ItyV128, ItyI8, ItyI32, ItyINVALID}},                     // IopSetElem32x4
+	{"SetElem64x2", ItyV128, [4]IRType{ItyV128, ItyI8, ItyI64, ItyINVALID}},                     // IopSetElem64x2
+	{"Dup8x16", ItyV128, [4]IRType{ItyI8, ItyINVALID, ItyINVALID, ItyINVALID}},                  // IopDup8x16
+	{"Dup16x8", ItyV128, [4]IRType{ItyI16, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopDup16x8
+	{"Dup32x4", ItyV128, [4]IRType{ItyI32, ItyINVALID, ItyINVALID, ItyINVALID}},                 // IopDup32x4
+	{"SliceV128", ItyV128, [4]IRType{ItyV128, ItyV128, ItyI8, ItyINVALID}},                      // IopSliceV128
+	{"Reverse8sIn16_x8", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},       // IopReverse8sIn16x8
+	{"Reverse8sIn32_x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},       // IopReverse8sIn32x4
+	{"Reverse16sIn32_x4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},      // IopReverse16sIn32x4
+	{"Reverse8sIn64_x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},       // IopReverse8sIn64x2
+	{"Reverse16sIn64_x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},      // IopReverse16sIn64x2
+	{"Reverse32sIn64_x2", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},      // IopReverse32sIn64x2
+	{"Reverse1sIn8_x16", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},       // IopReverse1sIn8x16
+	{"Perm8x16", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopPerm8x16
+	{"Perm32x4", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                  // IopPerm32x4
+	{"Perm8x16x2", ItyV128, [4]IRType{ItyV128, ItyV128, ItyV128, ItyINVALID}},                   // IopPerm8x16x2
+	{"GetMSBs8x16", ItyI16, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopGetMSBs8x16
+	{"RecipEst32Ux4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRecipEst32Ux4
+	{"RSqrtEst32Ux4", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRSqrtEst32Ux4
+	{"MulI128by10", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopMulI128by10
+	{"MulI128by10Carry", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},       // IopMulI128by10Carry
+	{"MulI128by10E", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopMulI128by10E
+	{"MulI128by10ECarry", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},         // IopMulI128by10ECarry
+	{"V256to64_0", ItyI64, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopV256to640
+	{"V256to64_1", ItyI64, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopV256to641
+	{"V256to64_2", ItyI64, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopV256to642
+	{"V256to64_3", ItyI64, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopV256to643
+	{"64x4toV256", ItyV256, [4]IRType{ItyI64, ItyI64, ItyI64, ItyI64}},                          // Iop64x4toV256
+	{"V256toV128_0", ItyV128, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},           // IopV256toV1280
+	{"V256toV128_1", ItyV128, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},           // IopV256toV1281
+	{"V128HLtoV256", ItyV256, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopV128HLtoV256
+	{"AndV256", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopAndV256
+	{"OrV256", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                    // IopOrV256
+	{"XorV256", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopXorV256
+	{"NotV256", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},                // IopNotV256
+	{"CmpNEZ8x32", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopCmpNEZ8x32
+	{"CmpNEZ16x16", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopCmpNEZ16x16
+	{"CmpNEZ32x8", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopCmpNEZ32x8
+	{"CmpNEZ64x4", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},             // IopCmpNEZ64x4
+	{"Add8x32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopAdd8x32
+	{"Add16x16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopAdd16x16
+	{"Add32x8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopAdd32x8
+	{"Add64x4", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopAdd64x4
+	{"Sub8x32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopSub8x32
+	{"Sub16x16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopSub16x16
+	{"Sub32x8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopSub32x8
+	{"Sub64x4", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopSub64x4
+	{"CmpEQ8x32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopCmpEQ8x32
+	{"CmpEQ16x16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                // IopCmpEQ16x16
+	{"CmpEQ32x8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopCmpEQ32x8
+	{"CmpEQ64x4", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopCmpEQ64x4
+	{"CmpGT8Sx32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                // IopCmpGT8Sx32
+	{"CmpGT16Sx16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},               // IopCmpGT16Sx16
+	{"CmpGT32Sx8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                // IopCmpGT32Sx8
+	{"CmpGT64Sx4", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                // IopCmpGT64Sx4
+	{"ShlN16x16", ItyV256, [4]IRType{ItyV256, ItyI8, ItyINVALID, ItyINVALID}},                   // IopShlN16x16
+	{"ShlN32x8", ItyV256, [4]IRType{ItyV256, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShlN32x8
+	{"ShlN64x4", ItyV256, [4]IRType{ItyV256, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShlN64x4
+	{"ShrN16x16", ItyV256, [4]IRType{ItyV256, ItyI8, ItyINVALID, ItyINVALID}},                   // IopShrN16x16
+	{"ShrN32x8", ItyV256, [4]IRType{ItyV256, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShrN32x8
+	{"ShrN64x4", ItyV256, [4]IRType{ItyV256, ItyI8, ItyINVALID, ItyINVALID}},                    // IopShrN64x4
+	{"SarN16x16", ItyV256, [4]IRType{ItyV256, ItyI8, ItyINVALID, ItyINVALID}},                   // IopSarN16x16
+	{"SarN32x8", ItyV256, [4]IRType{ItyV256, ItyI8, ItyINVALID, ItyINVALID}},                    // IopSarN32x8
+	{"Max8Sx32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMax8Sx32
+	{"Max16Sx16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopMax16Sx16
+	{"Max32Sx8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMax32Sx8
+	{"Max8Ux32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMax8Ux32
+	{"Max16Ux16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopMax16Ux16
+	{"Max32Ux8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMax32Ux8
+	{"Min8Sx32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMin8Sx32
+	{"Min16Sx16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopMin16Sx16
+	{"Min32Sx8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMin32Sx8
+	{"Min8Ux32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMin8Ux32
+	{"Min16Ux16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopMin16Ux16
+	{"Min32Ux8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMin32Ux8
+	{"Mul16x16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMul16x16
+	{"Mul32x8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                   // IopMul32x8
+	{"MulHi16Ux16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},               // IopMulHi16Ux16
+	{"MulHi16Sx16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},               // IopMulHi16Sx16
+	{"QAdd8Ux32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopQAdd8Ux32
+	{"QAdd16Ux16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                // IopQAdd16Ux16
+	{"QAdd8Sx32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopQAdd8Sx32
+	{"QAdd16Sx16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                // IopQAdd16Sx16
+	{"QSub8Ux32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopQSub8Ux32
+	{"QSub16Ux16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                // IopQSub16Ux16
+	{"QSub8Sx32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopQSub8Sx32
+	{"QSub16Sx16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                // IopQSub16Sx16
+	{"Avg8Ux32", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopAvg8Ux32
+	{"Avg16Ux16", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                 // IopAvg16Ux16
+	{"Perm32x8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopPerm32x8
+	{"CipherV128", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},                // IopCipherV128
+	{"CipherLV128", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopCipherLV128
+	{"CipherSV128", ItyV128, [4]IRType{ItyV128, ItyINVALID, ItyINVALID, ItyINVALID}},            // IopCipherSV128
+	{"NCipherV128", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},               // IopNCipherV128
+	{"NCipherLV128", ItyV128, [4]IRType{ItyV128, ItyV128, ItyINVALID, ItyINVALID}},              // IopNCipherLV128
+	{"SHA512", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                      // IopSHA512
+	{"SHA256", ItyV128, [4]IRType{ItyV128, ItyI8, ItyINVALID, ItyINVALID}},                      // IopSHA256
+	{"Add64Fx4", ItyV256, [4]IRType{ItyI32, ItyV256, ItyV256, ItyINVALID}},                      // IopAdd64Fx4
+	{"Sub64Fx4", ItyV256, [4]IRType{ItyI32, ItyV256, ItyV256, ItyINVALID}},                      // IopSub64Fx4
+	{"Mul64Fx4", ItyV256, [4]IRType{ItyI32, ItyV256, ItyV256, ItyINVALID}},                      // IopMul64Fx4
+	{"Div64Fx4", ItyV256, [4]IRType{ItyI32, ItyV256, ItyV256, ItyINVALID}},                      // IopDiv64Fx4
+	{"Add32Fx8", ItyV256, [4]IRType{ItyI32, ItyV256, ItyV256, ItyINVALID}},                      // IopAdd32Fx8
+	{"Sub32Fx8", ItyV256, [4]IRType{ItyI32, ItyV256, ItyV256, ItyINVALID}},                      // IopSub32Fx8
+	{"Mul32Fx8", ItyV256, [4]IRType{ItyI32, ItyV256, ItyV256, ItyINVALID}},                      // IopMul32Fx8
+	{"Div32Fx8", ItyV256, [4]IRType{ItyI32, ItyV256, ItyV256, ItyINVALID}},                      // IopDiv32Fx8
+	{"Sqrt32Fx8", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopSqrt32Fx8
+	{"Sqrt64Fx4", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},              // IopSqrt64Fx4
+	{"RSqrtEst32Fx8", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRSqrtEst32Fx8
+	{"RecipEst32Fx8", ItyV256, [4]IRType{ItyV256, ItyINVALID, ItyINVALID, ItyINVALID}},          // IopRecipEst32Fx8
+	{"Max32Fx8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMax32Fx8
+	{"Min32Fx8", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMin32Fx8
+	{"Max64Fx4", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMax64Fx4
+	{"Min64Fx4", ItyV256, [4]IRType{ItyV256, ItyV256, ItyINVALID, ItyINVALID}},                  // IopMin64Fx4
+}
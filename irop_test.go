@@ -0,0 +1,49 @@
+package vex_go
+
+import "testing"
+
+func TestIROpInfo(t *testing.T) {
+	if IopAdd64.String() != "Add64" || IopCmpEQ32Fx4.String() != "CmpEQ32Fx4" || IROp(0).String() != "IROp(0x0)" {
+		t.Fatalf("unexpected names %s %s", IopAdd64, IopCmpEQ32Fx4)
+	}
+	for op := IopINVALID; op < IopLAST; op++ {
+		if got, ok := ParseIROp(op.String()); !ok || got != op {
+			t.Fatalf("ParseIROp(%q) = %v, %v", op.String(), got, ok)
+		}
+	}
+	res, a1, a2, a3, _ := TypeOfPrimop(IopAddF64)
+	if res != ItyF64 || a1 != ItyI32 || a2 != ItyF64 || a3 != ItyF64 || IopAddF64.Arity() != 3 {
+		t.Fatalf("unexpected AddF64 type %v(%v, %v, %v)", res, a1, a2, a3)
+	}
+	if args := Iop64to32.ArgTypes(); len(args) != 1 || args[0] != ItyI64 || Iop64to32.ResultType() != ItyI32 {
+		t.Fatalf("unexpected 64to32 args %v", args)
+	}
+
+	cases := []struct {
+		op                       IROp
+		arith, cmp, conv, fp, rm bool
+		width, count             int
+	}{
+		{IopAdd64, true, false, false, false, false, 0, 0},
+		{IopAdd8x16, true, false, false, false, false, 8, 16},
+		{IopAdd32F0x4, true, false, false, true, false, 32, 1},
+		{IopSqrt64F0x2, true, false, false, true, false, 64, 1},
+		{IopPerm8x16x2, false, false, false, false, false, 8, 16},
+		{IopCmpEQ32Fx4, false, true, false, true, false, 32, 4},
+		{IopCmpLT64S, false, true, false, false, false, 0, 0},
+		{Iop64to32, false, false, true, false, false, 0, 0},
+		{IopF64toI32S, false, false, true, true, true, 0, 0},
+		{IopI32StoF64, false, false, true, true, false, 0, 0},
+		{IopReinterpF64asI64, false, false, true, true, false, 0, 0},
+		{IopSqrtF64, true, false, false, true, true, 0, 0},
+		{IopDivModU64to32, true, false, false, false, false, 0, 0},
+	}
+	for _, c := range cases {
+		w, n := c.op.Lanes()
+		if c.op.IsArithmetic() != c.arith || c.op.IsComparison() != c.cmp || c.op.IsConversion() != c.conv ||
+			c.op.IsFloat() != c.fp || c.op.HasRoundingMode() != c.rm || w != c.width || n != c.count || c.op.IsSIMD() != (n > 1) {
+			t.Errorf("%s: arith=%v cmp=%v conv=%v fp=%v rm=%v lanes=%dx%d simd=%v", c.op, c.op.IsArithmetic(), c.op.IsComparison(),
+				c.op.IsConversion(), c.op.IsFloat(), c.op.HasRoundingMode(), w, n, c.op.IsSIMD())
+		}
+	}
+}
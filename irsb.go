@@ -0,0 +1,111 @@
+package vex_go
+
+import (
+	"iter"
+	"unsafe"
+)
+
+// 本文件为 IRSb 提供切片与迭代器形式的访问。与 IRSb 本身一样，返回的指针指向 VEX 内存池，
+// 只能在 Lifter.Do 的回调中使用
+
+// GetStmts 返回全部语句
+func (isb *IRSb) GetStmts() []*IRStmt {
+	if isb.StmtsUsed <= 0 {
+		return nil
+	}
+	return append([]*IRStmt(nil), unsafe.Slice(isb.Stmts, int(isb.StmtsUsed))...)
+}
+
+// All 按顺序迭代语句的下标与语句
+func (isb *IRSb) All() iter.Seq2[int, *IRStmt] {
+	return func(yield func(int, *IRStmt) bool) {
+		for i := 0; i < int(isb.StmtsUsed); i++ {
+			if !yield(i, isb.GetStmt(i)) {
+				return
+			}
+		}
+	}
+}
+
+// Instructions 按客户机指令迭代：每次给出指令的 IMark 以及它与下一个 IMark 之间的语句（不含 IMark）。
+// 第一个 IMark 之前若有语句，会以 nil IMark 先给出
+func (isb *IRSb) Instructions() iter.Seq2[*IMark, []*IRStmt] {
+	return func(yield func(*IMark, []*IRStmt) bool) {
+		stmts := isb.GetStmts()
+		var mark *IMark
+		start := 0
+		for i, s := range stmts {
+			if s.Tag != IstIMark {
+				continue
+			}
+			if (mark != nil || i > start) && !yield(mark, stmts[start:i:i]) {
+				return
+			}
+			mark, start = s.AsIMark(), i+1
+		}
+		if mark != nil || start < len(stmts) {
+			yield(mark, stmts[start:])
+		}
+	}
+}
+
+// Exits 迭代块中的条件退出及其语句下标
+func (isb *IRSb) Exits() iter.Seq2[int, *Exit] {
+	return func(yield func(int, *Exit) bool) {
+		for i, s := range isb.All() {
+			if s.Tag == IstExit && !yield(i, s.AsExit()) {
+				return
+			}
+		}
+	}
+}
+
+// Temps 迭代类型环境中的临时变量及其类型
+func (isb *IRSb) Temps() iter.Seq2[IRTemp, IRType] {
+	return func(yield func(IRTemp, IRType) bool) {
+		if isb.TyEnv == nil {
+			return
+		}
+		for i := 0; i < int(isb.TyEnv.TypesUsed); i++ {
+			if !yield(IRTemp(i), isb.TyEnv.GetType(i)) {
+				return
+			}
+		}
+	}
+}
+
+// TypeOf 按 VEX typeOfIRExpr 的规则，根据块的类型环境与操作码签名计算表达式的类型，
+// 无法确定时返回 ItyINVALID
+func (isb *IRSb) TypeOf(e *IRExpr) IRType {
+	if e == nil {
+		return ItyINVALID
+	}
+	switch e.Tag {
+	case IexGet:
+		return e.AsGet().Ty
+	case IexGetI:
+		return e.AsGetI().Descr.ElemTy
+	case IexRdTmp:
+		if isb.TyEnv == nil {
+			return ItyINVALID
+		}
+		return isb.TyEnv.GetType(int(e.AsRdTmp().Tmp))
+	case IexQop:
+		return opResultType(e.AsQop().Details.Op)
+	case IexTriop:
+		return opResultType(e.AsTriop().Details.Op)
+	case IexBinop:
+		return opResultType(e.AsBinop().Op)
+	case IexUnop:
+		return opResultType(e.AsUnop().Op)
+	case IexLoad:
+		return e.AsLoad().Ty
+	case IexConst:
+		return e.AsConst().Con.Type()
+	case IexCCall:
+		return e.AsCCall().RetTy
+	case IexITE:
+		return isb.TypeOf(e.AsITE().IfTrue)
+	}
+	return ItyINVALID
+}
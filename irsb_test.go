@@ -0,0 +1,89 @@
+package vex_go
+
+import "testing"
+
+func TestIRSbIterators(t *testing.T) {
+	opts := DefaultLiftOptions()
+	opts.OptLevel = 0
+	l := NewLifter(VexArchAMD64, VexEndnessLE, opts)
+	// lock cmpxchg [rdi], rsi; cpuid; add rax, 8; cmove rax, rcx; jz 0
+	mc := []byte{0xf0, 0x48, 0x0f, 0xb1, 0x37, 0x0f, 0xa2, 0x48, 0x83, 0xc0, 0x08, 0x48, 0x0f, 0x44, 0xc1,
+		0x74, 0xef}
+	err := l.Do(mc, 0x400000, func(r *LiftResult) {
+		isb := r.IRSB
+		stmts := isb.GetStmts()
+		if len(stmts) != int(isb.StmtsUsed) {
+			t.Fatalf("GetStmts returned %d, want %d", len(stmts), isb.StmtsUsed)
+		}
+		for i, s := range isb.All() {
+			if s != stmts[i] || s != isb.GetStmt(i) {
+				t.Errorf("All: stmt %d differs", i)
+			}
+		}
+
+		var addrs []uint64
+		n := 0
+		for mark, body := range isb.Instructions() {
+			if mark == nil {
+				t.Fatal("unexpected statements before first IMark")
+			}
+			addrs = append(addrs, mark.Addr)
+			n += 1 + len(body)
+			for _, s := range body {
+				if s.Tag == IstIMark {
+					t.Errorf("IMark inside instruction body")
+				}
+			}
+		}
+		want := []uint64{0x400000, 0x400005, 0x400007, 0x40000b, 0x40000f}
+		if len(addrs) != len(want) || n != len(stmts) {
+			t.Fatalf("instructions at %#x covering %d stmts, want %#x covering %d", addrs, n, want, len(stmts))
+		}
+		for i := range want {
+			if addrs[i] != want[i] {
+				t.Errorf("instruction %d at %#x, want %#x", i, addrs[i], want[i])
+			}
+		}
+		for range isb.Instructions() {
+			break
+		}
+
+		exits := 0
+		for i, e := range isb.Exits() {
+			exits++
+			if stmts[i].Tag != IstExit || e.Dst.Copy().AsUint64() != 0x400000 {
+				t.Errorf("unexpected exit at %d", i)
+			}
+		}
+		if exits != 1 {
+			t.Errorf("got %d exits, want 1", exits)
+		}
+
+		temps := 0
+		for tmp, ty := range isb.Temps() {
+			if tmp != IRTemp(temps) || ty != isb.TyEnv.GetType(temps) {
+				t.Errorf("temp %v: %v", tmp, ty)
+			}
+			temps++
+		}
+		if temps != int(isb.TyEnv.TypesUsed) {
+			t.Errorf("got %d temps, want %d", temps, isb.TyEnv.TypesUsed)
+		}
+
+		for i, s := range isb.All() {
+			if s.Tag != IstWrTmp {
+				continue
+			}
+			w := s.AsWrTmp()
+			if got, want := isb.TypeOf(w.Data), isb.TyEnv.GetType(int(w.Tmp)); got != want {
+				t.Errorf("stmt %d: TypeOf(%v) = %v, want %v", i, w.Data, got, want)
+			}
+		}
+		if ty := isb.TypeOf(isb.Next); ty != ItyI64 {
+			t.Errorf("TypeOf(next) = %v", ty)
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,538 @@
+package vex_go
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// 本文件定义 IR 的 JSON 格式：
+//   - 枚举以 VEX 头文件中的名称表示，如 "Ist_Put"、"Ity_I64"；IROp 使用 ppIROp 的名称，如 "Add64"
+//   - 语句与表达式是带 "tag" 字段的对象，其余字段与 Go 结构体的字段一一对应，
+//     Qop/Triop/Binop/Unop 的操作数放在 "args" 数组中
+//   - 常量为 {"tag": "Ico_U64", "bits": "0x8"}，bits 使用十六进制字符串以免 64 位整数丢失精度
+//   - Callee 的宿主机函数地址随进程变化，不写入 JSON，解码后为 0
+//   - 块的最外层带有 "version" 字段，值为 IRJSONVersion
+
+// IRJSONVersion 是 Block 的 JSON 格式版本，格式发生不兼容的变化时递增
+const IRJSONVersion = 1
+
+func enumText[T ~uint32](names map[T]string, v T, typ string) ([]byte, error) {
+	if s, ok := names[v]; ok {
+		return []byte(s), nil
+	}
+	return nil, fmt.Errorf("vex: invalid %s %#x", typ, uint32(v))
+}
+
+func (v IRStmtTag) MarshalText() ([]byte, error) {
+	return enumText(irStmtTagNames, v, "IRStmtTag")
+}
+
+func (v IRExprTag) MarshalText() ([]byte, error) {
+	return enumText(irExprTagNames, v, "IRExprTag")
+}
+
+func (v IRType) MarshalText() ([]byte, error) {
+	return enumText(irTypeNames, v, "IRType")
+}
+
+func (v IRConstTag) MarshalText() ([]byte, error) {
+	return enumText(irConstTagNames, v, "IRConstTag")
+}
+
+func (v IRJumpKind) MarshalText() ([]byte, error) {
+	return enumText(irJumpKindNames, v, "IRJumpKind")
+}
+
+func (v IREndness) MarshalText() ([]byte, error) {
+	return enumText(irEndnessNames, v, "IREndness")
+}
+
+func (v IREffect) MarshalText() ([]byte, error) {
+	return enumText(irEffectNames, v, "IREffect")
+}
+
+func (v IRMBusEvent) MarshalText() ([]byte, error) {
+	return enumText(irMBusEventNames, v, "IRMBusEvent")
+}
+
+func (v IRLoadGOp) MarshalText() ([]byte, error) {
+	return enumText(irLoadGOpNames, v, "IRLoadGOp")
+}
+
+func (v VexArch) MarshalText() ([]byte, error) {
+	return enumText(vexArchNames, v, "VexArch")
+}
+
+func (v IROp) MarshalText() ([]byte, error) {
+	if _, ok := v.info(); !ok {
+		return nil, fmt.Errorf("vex: invalid IROp %#x", uint32(v))
+	}
+	return []byte(v.String()), nil
+}
+
+func unmarshalEnum[T ~uint32](names map[T]string, v *T, text []byte, typ string) error {
+	r, err := enumParse(names, string(text), typ)
+	if err == nil {
+		*v = r
+	}
+	return err
+}
+
+func (v *IRStmtTag) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irStmtTagNames, v, b, "IRStmtTag")
+}
+
+func (v *IRExprTag) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irExprTagNames, v, b, "IRExprTag")
+}
+
+func (v *IRType) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irTypeNames, v, b, "IRType")
+}
+
+func (v *IRConstTag) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irConstTagNames, v, b, "IRConstTag")
+}
+
+func (v *IRJumpKind) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irJumpKindNames, v, b, "IRJumpKind")
+}
+
+func (v *IREndness) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irEndnessNames, v, b, "IREndness")
+}
+
+func (v *IREffect) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irEffectNames, v, b, "IREffect")
+}
+
+func (v *IRMBusEvent) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irMBusEventNames, v, b, "IRMBusEvent")
+}
+
+func (v *IRLoadGOp) UnmarshalText(b []byte) error {
+	return unmarshalEnum(irLoadGOpNames, v, b, "IRLoadGOp")
+}
+
+func (v *VexArch) UnmarshalText(b []byte) error {
+	return unmarshalEnum(vexArchNames, v, b, "VexArch")
+}
+
+func (v *IROp) UnmarshalText(b []byte) error {
+	op, ok := ParseIROp(string(b))
+	if !ok {
+		return fmt.Errorf("vex: unknown IROp %q", b)
+	}
+	*v = op
+	return nil
+}
+
+type constantJSON struct {
+	Tag  IRConstTag `json:"tag"`
+	Bits string     `json:"bits"`
+}
+
+func (c Constant) MarshalJSON() ([]byte, error) {
+	return json.Marshal(constantJSON{c.Tag, "0x" + strconv.FormatUint(c.Bits, 16)})
+}
+
+func (c *Constant) UnmarshalJSON(data []byte) error {
+	var j constantJSON
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	bits, err := strconv.ParseUint(j.Bits, 0, 64)
+	if err != nil {
+		return fmt.Errorf("vex: invalid constant bits %q", j.Bits)
+	}
+	*c = Constant{Tag: j.Tag, Bits: bits}
+	return nil
+}
+
+type jsonObject = map[string]any
+
+func stmtJSON(s Stmt) jsonObject {
+	o := jsonObject{"tag": s.Tag()}
+	switch s := s.(type) {
+	case *NoOpStmt:
+	case *IMarkStmt:
+		o["addr"], o["len"], o["delta"] = s.Addr, s.Len, s.Delta
+	case *AbiHintStmt:
+		o["base"], o["len"], o["nia"] = s.Base, s.Len, s.Nia
+	case *PutStmt:
+		o["offset"], o["data"] = s.Offset, s.Data
+	case *PutIStmt:
+		o["descr"], o["ix"], o["bias"], o["data"] = s.Descr, s.Ix, s.Bias, s.Data
+	case *WrTmpStmt:
+		o["tmp"], o["data"] = s.Tmp, s.Data
+	case *StoreStmt:
+		o["end"], o["addr"], o["data"] = s.End, s.Addr, s.Data
+	case *LoadGStmt:
+		o["end"], o["cvt"], o["dst"] = s.End, s.Cvt, s.Dst
+		o["addr"], o["alt"], o["guard"] = s.Addr, s.Alt, s.Guard
+	case *StoreGStmt:
+		o["end"], o["addr"], o["data"], o["guard"] = s.End, s.Addr, s.Data, s.Guard
+	case *CASStmt:
+		o["old_hi"], o["old_lo"], o["end"], o["addr"] = s.OldHi, s.OldLo, s.End, s.Addr
+		o["expd_hi"], o["expd_lo"], o["data_hi"], o["data_lo"] = s.ExpdHi, s.ExpdLo, s.DataHi, s.DataLo
+	case *LLSCStmt:
+		o["end"], o["result"], o["addr"], o["store_data"] = s.End, s.Result, s.Addr, s.StoreData
+	case *DirtyStmt:
+		o["cee"], o["guard"], o["args"], o["tmp"] = s.Cee, s.Guard, s.Args, s.Tmp
+		o["mfx"], o["maddr"], o["msize"], o["fx_state"] = s.MFx, s.MAddr, s.MSize, s.FxState
+	case *MBEStmt:
+		o["event"] = s.Event
+	case *ExitStmt:
+		o["guard"], o["dst"], o["jk"], o["offs_ip"] = s.Guard, s.Dst, s.Jk, s.OffsIP
+	}
+	return o
+}
+
+func exprJSON(e Expr) jsonObject {
+	o := jsonObject{"tag": e.Tag()}
+	switch e := e.(type) {
+	case *BinderExpr:
+		o["binder"] = e.Binder
+	case *GetExpr:
+		o["offset"], o["ty"] = e.Offset, e.Ty
+	case *GetIExpr:
+		o["descr"], o["ix"], o["bias"] = e.Descr, e.Ix, e.Bias
+	case *RdTmpExpr:
+		o["tmp"] = e.Tmp
+	case *QopExpr:
+		o["op"], o["args"] = e.Op, []Expr{e.Arg1, e.Arg2, e.Arg3, e.Arg4}
+	case *TriopExpr:
+		o["op"], o["args"] = e.Op, []Expr{e.Arg1, e.Arg2, e.Arg3}
+	case *BinopExpr:
+		o["op"], o["args"] = e.Op, []Expr{e.Arg1, e.Arg2}
+	case *UnopExpr:
+		o["op"], o["args"] = e.Op, []Expr{e.Arg}
+	case *LoadExpr:
+		o["end"], o["ty"], o["addr"] = e.End, e.Ty, e.Addr
+	case *ConstExpr:
+		o["con"] = e.Con
+	case *ITEExpr:
+		o["cond"], o["iftrue"], o["iffalse"] = e.Cond, e.IfTrue, e.IfFalse
+	case *CCallExpr:
+		o["cee"], o["retty"], o["args"] = e.Cee, e.RetTy, e.Args
+	case *VECRETExpr, *GSPTRExpr:
+	}
+	return o
+}
+
+func (s *NoOpStmt) MarshalJSON() ([]byte, error)    { return json.Marshal(stmtJSON(s)) }
+func (s *IMarkStmt) MarshalJSON() ([]byte, error)   { return json.Marshal(stmtJSON(s)) }
+func (s *AbiHintStmt) MarshalJSON() ([]byte, error) { return json.Marshal(stmtJSON(s)) }
+func (s *PutStmt) MarshalJSON() ([]byte, error)     { return json.Marshal(stmtJSON(s)) }
+func (s *PutIStmt) MarshalJSON() ([]byte, error)    { return json.Marshal(stmtJSON(s)) }
+func (s *WrTmpStmt) MarshalJSON() ([]byte, error)   { return json.Marshal(stmtJSON(s)) }
+func (s *StoreStmt) MarshalJSON() ([]byte, error)   { return json.Marshal(stmtJSON(s)) }
+func (s *LoadGStmt) MarshalJSON() ([]byte, error)   { return json.Marshal(stmtJSON(s)) }
+func (s *StoreGStmt) MarshalJSON() ([]byte, error)  { return json.Marshal(stmtJSON(s)) }
+func (s *CASStmt) MarshalJSON() ([]byte, error)     { return json.Marshal(stmtJSON(s)) }
+func (s *LLSCStmt) MarshalJSON() ([]byte, error)    { return json.Marshal(stmtJSON(s)) }
+func (s *DirtyStmt) MarshalJSON() ([]byte, error)   { return json.Marshal(stmtJSON(s)) }
+func (s *MBEStmt) MarshalJSON() ([]byte, error)     { return json.Marshal(stmtJSON(s)) }
+func (s *ExitStmt) MarshalJSON() ([]byte, error)    { return json.Marshal(stmtJSON(s)) }
+
+func (e *BinderExpr) MarshalJSON() ([]byte, error) { return json.Marshal(exprJSON(e)) }
+func (e *GetExpr) MarshalJSON() ([]byte, error)    { return json.Marshal(exprJSON(e)) }
+func (e *GetIExpr) MarshalJSON() ([]byte, error)   { return json.Marshal(exprJSON(e)) }
+func (e *RdTmpExpr) MarshalJSON() ([]byte, error)  { return json.Marshal(exprJSON(e)) }
+func (e *QopExpr) MarshalJSON() ([]byte, error)    { return json.Marshal(exprJSON(e)) }
+func (e *TriopExpr) MarshalJSON() ([]byte, error)  { return json.Marshal(exprJSON(e)) }
+func (e *BinopExpr) MarshalJSON() ([]byte, error)  { return json.Marshal(exprJSON(e)) }
+func (e *UnopExpr) MarshalJSON() ([]byte, error)   { return json.Marshal(exprJSON(e)) }
+func (e *LoadExpr) MarshalJSON() ([]byte, error)   { return json.Marshal(exprJSON(e)) }
+func (e *ConstExpr) MarshalJSON() ([]byte, error)  { return json.Marshal(exprJSON(e)) }
+func (e *ITEExpr) MarshalJSON() ([]byte, error)    { return json.Marshal(exprJSON(e)) }
+func (e *CCallExpr) MarshalJSON() ([]byte, error)  { return json.Marshal(exprJSON(e)) }
+func (e *VECRETExpr) MarshalJSON() ([]byte, error) { return json.Marshal(exprJSON(e)) }
+func (e *GSPTRExpr) MarshalJSON() ([]byte, error)  { return json.Marshal(exprJSON(e)) }
+
+// jsonDecoder 从 JSON 对象中逐个读取字段，记录遇到的第一个错误
+type jsonDecoder struct {
+	obj map[string]json.RawMessage
+	err error
+}
+
+func (d *jsonDecoder) field(key string, v any) {
+	if d.err != nil {
+		return
+	}
+	raw, ok := d.obj[key]
+	if !ok {
+		d.err = fmt.Errorf("missing field %q", key)
+		return
+	}
+	if err := json.Unmarshal(raw, v); err != nil {
+		d.err = fmt.Errorf("field %q: %w", key, err)
+	}
+}
+
+func (d *jsonDecoder) expr(key string) Expr {
+	var raw json.RawMessage
+	d.field(key, &raw)
+	if d.err != nil {
+		return nil
+	}
+	e, err := UnmarshalExprJSON(raw)
+	if err != nil {
+		d.err = fmt.Errorf("field %q: %w", key, err)
+	}
+	return e
+}
+
+func (d *jsonDecoder) exprs(key string) []Expr {
+	var raws []json.RawMessage
+	d.field(key, &raws)
+	if d.err != nil || raws == nil {
+		return nil
+	}
+	es := make([]Expr, len(raws))
+	for i, raw := range raws {
+		e, err := UnmarshalExprJSON(raw)
+		if err != nil {
+			d.err = fmt.Errorf("field %q[%d]: %w", key, i, err)
+			return nil
+		}
+		es[i] = e
+	}
+	return es
+}
+
+// opArgs 读取操作码及其 n 个操作数
+func (d *jsonDecoder) opArgs(n int) (IROp, []Expr) {
+	var op IROp
+	d.field("op", &op)
+	args := d.exprs("args")
+	if d.err == nil && len(args) != n {
+		d.err = fmt.Errorf("%v takes %d args, got %d", op, n, len(args))
+	}
+	if d.err != nil {
+		return op, make([]Expr, n)
+	}
+	return op, args
+}
+
+func isJSONNull(data []byte) bool {
+	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
+}
+
+// UnmarshalStmtJSON 解析单条语句，null 返回 nil
+func UnmarshalStmtJSON(data []byte) (Stmt, error) {
+	if isJSONNull(data) {
+		return nil, nil
+	}
+	d := &jsonDecoder{}
+	if err := json.Unmarshal(data, &d.obj); err != nil {
+		return nil, err
+	}
+	var tag IRStmtTag
+	d.field("tag", &tag)
+	if d.err != nil {
+		return nil, fmt.Errorf("vex: decode statement: %w", d.err)
+	}
+	var s Stmt
+	switch tag {
+	case IstNoOp:
+		s = &NoOpStmt{}
+	case IstIMark:
+		x := &IMarkStmt{}
+		d.field("addr", &x.Addr)
+		d.field("len", &x.Len)
+		d.field("delta", &x.Delta)
+		s = x
+	case IstAbiHint:
+		x := &AbiHintStmt{Base: d.expr("base"), Nia: d.expr("nia")}
+		d.field("len", &x.Len)
+		s = x
+	case IstPut:
+		x := &PutStmt{Data: d.expr("data")}
+		d.field("offset", &x.Offset)
+		s = x
+	case IstPutI:
+		x := &PutIStmt{Ix: d.expr("ix"), Data: d.expr("data")}
+		d.field("descr", &x.Descr)
+		d.field("bias", &x.Bias)
+		s = x
+	case IstWrTmp:
+		x := &WrTmpStmt{Data: d.expr("data")}
+		d.field("tmp", &x.Tmp)
+		s = x
+	case IstStore:
+		x := &StoreStmt{Addr: d.expr("addr"), Data: d.expr("data")}
+		d.field("end", &x.End)
+		s = x
+	case IstLoadG:
+		x := &LoadGStmt{Addr: d.expr("addr"), Alt: d.expr("alt"), Guard: d.expr("guard")}
+		d.field("end", &x.End)
+		d.field("cvt", &x.Cvt)
+		d.field("dst", &x.Dst)
+		s = x
+	case IstStoreG:
+		x := &StoreGStmt{Addr: d.expr("addr"), Data: d.expr("data"), Guard: d.expr("guard")}
+		d.field("end", &x.End)
+		s = x
+	case IstCAS:
+		x := &CASStmt{Addr: d.expr("addr"), ExpdHi: d.expr("expd_hi"), ExpdLo: d.expr("expd_lo"),
+			DataHi: d.expr("data_hi"), DataLo: d.expr("data_lo")}
+		d.field("old_hi", &x.OldHi)
+		d.field("old_lo", &x.OldLo)
+		d.field("end", &x.End)
+		s = x
+	case IstLLSC:
+		x := &LLSCStmt{Addr: d.expr("addr"), StoreData: d.expr("store_data")}
+		d.field("end", &x.End)
+		d.field("result", &x.Result)
+		s = x
+	case IstDirty:
+		x := &DirtyStmt{Guard: d.expr("guard"), Args: d.exprs("args"), MAddr: d.expr("maddr")}
+		d.field("cee", &x.Cee)
+		d.field("tmp", &x.Tmp)
+		d.field("mfx", &x.MFx)
+		d.field("msize", &x.MSize)
+		d.field("fx_state", &x.FxState)
+		s = x
+	case IstMBE:
+		x := &MBEStmt{}
+		d.field("event", &x.Event)
+		s = x
+	case IstExit:
+		x := &ExitStmt{Guard: d.expr("guard")}
+		d.field("dst", &x.Dst)
+		d.field("jk", &x.Jk)
+		d.field("offs_ip", &x.OffsIP)
+		s = x
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("vex: decode %v: %w", tag, d.err)
+	}
+	return s, nil
+}
+
+// UnmarshalExprJSON 解析单个表达式，null 返回 nil
+func UnmarshalExprJSON(data []byte) (Expr, error) {
+	if isJSONNull(data) {
+		return nil, nil
+	}
+	d := &jsonDecoder{}
+	if err := json.Unmarshal(data, &d.obj); err != nil {
+		return nil, err
+	}
+	var tag IRExprTag
+	d.field("tag", &tag)
+	if d.err != nil {
+		return nil, fmt.Errorf("vex: decode expression: %w", d.err)
+	}
+	var e Expr
+	switch tag {
+	case IexBinder:
+		x := &BinderExpr{}
+		d.field("binder", &x.Binder)
+		e = x
+	case IexGet:
+		x := &GetExpr{}
+		d.field("offset", &x.Offset)
+		d.field("ty", &x.Ty)
+		e = x
+	case IexGetI:
+		x := &GetIExpr{Ix: d.expr("ix")}
+		d.field("descr", &x.Descr)
+		d.field("bias", &x.Bias)
+		e = x
+	case IexRdTmp:
+		x := &RdTmpExpr{}
+		d.field("tmp", &x.Tmp)
+		e = x
+	case IexQop:
+		op, a := d.opArgs(4)
+		e = &QopExpr{Op: op, Arg1: a[0], Arg2: a[1], Arg3: a[2], Arg4: a[3]}
+	case IexTriop:
+		op, a := d.opArgs(3)
+		e = &TriopExpr{Op: op, Arg1: a[0], Arg2: a[1], Arg3: a[2]}
+	case IexBinop:
+		op, a := d.opArgs(2)
+		e = &BinopExpr{Op: op, Arg1: a[0], Arg2: a[1]}
+	case IexUnop:
+		op, a := d.opArgs(1)
+		e = &UnopExpr{Op: op, Arg: a[0]}
+	case IexLoad:
+		x := &LoadExpr{Addr: d.expr("addr")}
+		d.field("end", &x.End)
+		d.field("ty", &x.Ty)
+		e = x
+	case IexConst:
+		x := &ConstExpr{}
+		d.field("con", &x.Con)
+		e = x
+	case IexITE:
+		e = &ITEExpr{Cond: d.expr("cond"), IfTrue: d.expr("iftrue"), IfFalse: d.expr("iffalse")}
+	case IexCCall:
+		x := &CCallExpr{Args: d.exprs("args")}
+		d.field("cee", &x.Cee)
+		d.field("retty", &x.RetTy)
+		e = x
+	case IexVECRET:
+		e = &VECRETExpr{}
+	case IexGSPTR:
+		e = &GSPTRExpr{}
+	}
+	if d.err != nil {
+		return nil, fmt.Errorf("vex: decode %v: %w", tag, d.err)
+	}
+	return e, nil
+}
+
+type blockJSON struct {
+	Version  int        `json:"version"`
+	Arch     VexArch    `json:"arch"`
+	TypeEnv  []IRType   `json:"type_env"`
+	Stmts    []Stmt     `json:"stmts"`
+	Next     Expr       `json:"next"`
+	JumpKind IRJumpKind `json:"jumpkind"`
+	OffsIP   int32      `json:"offs_ip"`
+}
+
+// MarshalJSON 将块编码为版本为 IRJSONVersion 的 JSON
+func (b *Block) MarshalJSON() ([]byte, error) {
+	return json.Marshal(blockJSON{IRJSONVersion, b.Arch, b.TypeEnv, b.Stmts, b.Next, b.JumpKind, b.OffsIP})
+}
+
+// UnmarshalJSON 解析 MarshalJSON 的输出，版本不符时返回 ErrVersion
+func (b *Block) UnmarshalJSON(data []byte) error {
+	var j struct {
+		blockJSON
+		Stmts []json.RawMessage `json:"stmts"`
+		Next  json.RawMessage   `json:"next"`
+	}
+	if err := json.Unmarshal(data, &j); err != nil {
+		return err
+	}
+	if j.Version != IRJSONVersion {
+		return fmt.Errorf("%w: JSON version %d, want %d", ErrVersion, j.Version, IRJSONVersion)
+	}
+	nb := Block{Arch: j.Arch, TypeEnv: j.TypeEnv, JumpKind: j.JumpKind, OffsIP: j.OffsIP}
+	nb.Stmts = make([]Stmt, len(j.Stmts))
+	for i, raw := range j.Stmts {
+		s, err := UnmarshalStmtJSON(raw)
+		if err != nil {
+			return fmt.Errorf("stmt %d: %w", i, err)
+		}
+		nb.Stmts[i] = s
+	}
+	next, err := UnmarshalExprJSON(j.Next)
+	if err != nil {
+		return fmt.Errorf("next: %w", err)
+	}
+	nb.Next = next
+	*b = nb
+	return nil
+}
+
+// MarshalJSON 复制 VEX 内存中的块并编码为 JSON，Arch 为 VexArchInvalid
+func (isb *IRSb) MarshalJSON() ([]byte, error) {
+	return isb.Copy().MarshalJSON()
+}
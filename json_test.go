@@ -0,0 +1,116 @@
+package vex_go
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// liftTestBlocks 提升一组覆盖大部分语句与表达式类型的块，用于序列化测试
+func liftTestBlocks(t *testing.T) []*Block {
+	cases := []struct {
+		arch VexArch
+		mc   []byte
+	}{
+		// lock cmpxchg [rdi], rsi; cpuid; add rax, 8; cmove rax, rcx; jz 0
+		{VexArchAMD64, []byte{0xf0, 0x48, 0x0f, 0xb1, 0x37, 0x0f, 0xa2, 0x48, 0x83, 0xc0, 0x08,
+			0x48, 0x0f, 0x44, 0xc1, 0x74, 0xef}},
+		// fld st(1); faddp st(1); call 0
+		{VexArchAMD64, []byte{0xd9, 0xc1, 0xde, 0xc1, 0xe8, 0x00, 0x00, 0x00, 0x00}},
+		// ldreq r0, [r1]; streq r0, [r1]; bx lr
+		{VexArchARM, []byte{0x00, 0x00, 0x91, 0x05, 0x00, 0x00, 0x81, 0x05, 0x1e, 0xff, 0x2f, 0xe1}},
+		// ldxr x0, [x1]; stxr w2, x0, [x1]; dmb ish; fmadd d0, d1, d2, d3; ret
+		{VexArchARM64, []byte{0x20, 0x7c, 0x5f, 0xc8, 0x20, 0x7c, 0x02, 0xc8, 0xbf, 0x3b, 0x03, 0xd5,
+			0x20, 0x0c, 0x42, 0x1f, 0xc0, 0x03, 0x5f, 0xd6}},
+	}
+	var blocks []*Block
+	for _, c := range cases {
+		opts := DefaultLiftOptions()
+		opts.OptLevel = 0
+		r, err := NewLifter(c.arch, VexEndnessLE, opts).Lift(c.mc, 0x400000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, r.Block)
+	}
+	return blocks
+}
+
+// clearCalleeAddrs 清除块中辅助函数的宿主机地址，它们不参与序列化
+func clearCalleeAddrs(b *Block) {
+	Inspect(b, func(n Node) bool {
+		switch n := n.(type) {
+		case *DirtyStmt:
+			n.Cee.Addr = 0
+		case *CCallExpr:
+			n.Cee.Addr = 0
+		}
+		return true
+	})
+}
+
+func TestBlockJSONRoundTrip(t *testing.T) {
+	for _, blk := range liftTestBlocks(t) {
+		data, err := json.Marshal(blk)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var b Block
+		if err := json.Unmarshal(data, &b); err != nil {
+			t.Fatalf("%v: %v", blk.Arch, err)
+		}
+		// 宿主机地址不写入 JSON，同一个块在不同进程中编码结果相同
+		clearCalleeAddrs(blk)
+		if cleared, _ := json.Marshal(blk); string(cleared) != string(data) {
+			t.Errorf("%v: JSON depends on callee addresses", blk.Arch)
+		}
+		if !reflect.DeepEqual(&b, blk) {
+			t.Errorf("%v: round trip mismatch:\n%s\n%s", blk.Arch, &b, blk)
+		}
+		again, err := json.Marshal(&b)
+		if err != nil || string(again) != string(data) {
+			t.Errorf("%v: re-encoded JSON differs: %v", blk.Arch, err)
+		}
+	}
+}
+
+func TestBlockJSONSchema(t *testing.T) {
+	b := &Block{
+		Arch:    VexArchAMD64,
+		TypeEnv: []IRType{ItyI64},
+		Stmts: []Stmt{
+			&WrTmpStmt{Tmp: 0, Data: &BinopExpr{Op: IopAdd64, Arg1: &GetExpr{Offset: 16, Ty: ItyI64},
+				Arg2: &ConstExpr{Con: Constant{Tag: IcoU64, Bits: 8}}}},
+		},
+		Next:     &RdTmpExpr{Tmp: 0},
+		JumpKind: IjkBoring,
+		OffsIP:   184,
+	}
+	data, err := json.Marshal(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"version":1,"arch":"VexArchAMD64","type_env":["Ity_I64"],"stmts":[{"data":{"args":[` +
+		`{"offset":16,"tag":"Iex_Get","ty":"Ity_I64"},{"con":{"tag":"Ico_U64","bits":"0x8"},"tag":"Iex_Const"}],` +
+		`"op":"Add64","tag":"Iex_Binop"},"tag":"Ist_WrTmp","tmp":0}],"next":{"tag":"Iex_RdTmp","tmp":0},` +
+		`"jumpkind":"Ijk_Boring","offs_ip":184}`
+	if string(data) != want {
+		t.Errorf("got  %s\nwant %s", data, want)
+	}
+
+	var out Block
+	bad := strings.Replace(want, `"version":1`, `"version":2`, 1)
+	if err := json.Unmarshal([]byte(bad), &out); !errors.Is(err, ErrVersion) {
+		t.Errorf("expected ErrVersion, got %v", err)
+	}
+	bad = strings.Replace(want, `"Add64"`, `"Add65"`, 1)
+	if err := json.Unmarshal([]byte(bad), &out); err == nil || !strings.Contains(err.Error(), "Add65") {
+		t.Errorf("expected unknown IROp error, got %v", err)
+	}
+	bad = strings.Replace(want, `"offset":16,`, ``, 1)
+	if err := json.Unmarshal([]byte(bad), &out); err == nil || !strings.Contains(err.Error(), `"offset"`) {
+		t.Errorf("expected missing field error, got %v", err)
+	}
+}
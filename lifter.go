@@ -0,0 +1,169 @@
+package vex_go
+
+import "errors"
+
+// Lifter 是可在多个 goroutine 间共享的提升器句柄。
+//
+// VEX 内部使用全局状态，所有提升（包括 VexLift）都会在同一把锁上串行执行。
+// Do 在持锁期间调用回调，因此回调中访问的 LiftResult.IRSB 及其中的语句、
+// 表达式在回调返回前不会被其他 goroutine 的提升覆盖；回调返回后不得再使用
+// 这些指针。LiftResult 中除 IRSB 与 ExitInfo.Stmt 外的字段均为 Go 内存，
+// 可以在回调之外保留。
+type Lifter struct {
+	arch    VexArch
+	endness VexEndness
+	opts    LiftOptions
+	initErr error // VexInit 的错误，非 nil 时所有提升都返回它
+}
+
+// NewLifter 创建一个提升器，opts 为 nil 时使用 DefaultLiftOptions。
+// opts 会被复制，之后对其的修改不会影响已创建的 Lifter。
+// VEX 初始化失败时，之后的每次提升都返回包装了 ErrInit 的 *LiftError
+func NewLifter(arch VexArch, en VexEndness, opts *LiftOptions) *Lifter {
+	if opts == nil {
+		opts = DefaultLiftOptions()
+	}
+	l := &Lifter{arch: arch, endness: en, opts: *opts, initErr: VexInit()}
+	if opts.ArchInfo != nil {
+		ai := *opts.ArchInfo
+		l.opts.ArchInfo = &ai
+	}
+	return l
+}
+
+// Arch 返回提升器的客户机架构
+func (l *Lifter) Arch() VexArch {
+	return l.arch
+}
+
+// Do 提升 mc 并在持锁期间以结果调用 fn，提升失败时返回 *LiftError 且不调用 fn
+func (l *Lifter) Do(mc []byte, insAddr uint64, fn func(r *LiftResult)) error {
+	if l.initErr != nil {
+		return &LiftError{Err: l.initErr, Arch: l.arch, Addr: insAddr}
+	}
+	liftMu.Lock()
+	defer liftMu.Unlock()
+	opts := l.opts
+	r, err := vexLift(l.arch, mc, insAddr, l.endness, &opts)
+	if err != nil {
+		return err
+	}
+	fn(r)
+	return nil
+}
+
+// Lift 提升 mc 并将结果完整复制到 Go 内存，返回的 LiftResult 中 Block 有效，
+// IRSB 与 ExitInfo.Stmt 为 nil
+func (l *Lifter) Lift(mc []byte, insAddr uint64) (*LiftResult, error) {
+	var res *LiftResult
+	err := l.Do(mc, insAddr, func(r *LiftResult) {
+		r.Block = r.IRSB.Copy()
+		r.Block.Arch = l.arch
+		r.IRSB = nil
+		for i := range r.Exits {
+			r.Exits[i].Stmt = nil
+		}
+		res = r
+	})
+	return res, err
+}
+
+// LiftBlock 与 Lift 相同，但块中间遇到无法解码的指令时，VEX 生成的块会以 Ijk_NoDecode 结束，
+// 此时按已解码的字节数重新提升，得到以 Ijk_Boring 跳转到该指令的块，并设置 Truncated。
+// 块因最后一条指令超出输入末尾而提前结束时同样设置 Truncated，块以 Ijk_Boring 跳转到这条不完整的指令。
+// 第一条指令就无法解码时仍返回 ErrDecode
+func (l *Lifter) LiftBlock(mc []byte, insAddr uint64) (*LiftResult, error) {
+	r, err := l.Lift(mc, insAddr)
+	if err != nil {
+		return r, err
+	}
+	if r.Block.JumpKind != IjkNoDecode {
+		r.Truncated = l.cutOff(mc, insAddr, r)
+		return r, nil
+	}
+	// VEX 的 Size 不含无法解码的指令，InstAddrs 中则包含它
+	t := *l
+	t.opts.MaxBytes = uint32(r.Size)
+	r, err = t.Lift(mc, insAddr)
+	if err != nil {
+		return nil, err
+	}
+	r.Truncated = true
+	return r, nil
+}
+
+// cutOff 判断块是否因下一条指令超出输入末尾而结束。VEX 在指令超出 guest_max_bytes 时回滚该指令，
+// 块以 Ijk_Boring 顺序执行到它；下一条指令能否完整解码则需单独提升它来确定
+func (l *Lifter) cutOff(mc []byte, insAddr uint64, r *LiftResult) bool {
+	avail := len(mc) - int(l.opts.LookbackAmount)
+	if r.Size >= avail || int(l.opts.MaxBytes) < avail || r.Insts() >= int(l.opts.MaxInsns) || r.Block.JumpKind != IjkBoring ||
+		!r.IsDefaultExitConstant || r.DefaultExit != insAddr+uint64(r.Size) {
+		return false
+	}
+	t := *l
+	t.opts.MaxInsns = 1
+	_, err := t.Lift(mc[r.Size:], insAddr+uint64(r.Size))
+	return errors.Is(err, ErrTruncated)
+}
+
+// LiftBlock 以架构的默认字节序提升 code 中从 addr 开始的一个基本块，块在控制流指令、
+// opts.MaxInsns/MaxBytes 或输入末尾处结束，opts 为 nil 时使用 DefaultLiftOptions。
+// 结果位于 Go 内存中，Size、InstAddrs 给出实际提升的字节数与各条指令的地址，截断规则见 Lifter.LiftBlock
+func LiftBlock(arch VexArch, code []byte, addr uint64, opts *LiftOptions) (*LiftResult, error) {
+	d := GetArchDescriptor(arch)
+	if d == nil {
+		return nil, &LiftError{Err: ErrUnsupportedArch, Arch: arch, Addr: addr}
+	}
+	return NewLifter(arch, d.DefaultEndness, opts).LiftBlock(code, addr)
+}
+
+// InstructionIR 是单独提升一条机器指令得到的 IR
+type InstructionIR struct {
+	Addr  uint64 // 指令地址，Thumb 指令的最低位为 1
+	Len   int    // 指令字节数
+	Bytes []byte // 指令的原始字节，与输入共享底层数组
+	Stmts []Stmt // 指令 IMark 之后的全部语句
+	Block *Block // 只包含这条指令的完整块，提供类型环境与 Next/JumpKind
+}
+
+// LiftInstructions 从 insAddr 开始逐条提升 mc 中的指令，每条指令单独成块（MaxInsns 为 1），
+// 因此遇到跳转不会停止。n > 0 时最多提升 n 条，否则提升到输入末尾。
+// 某条指令提升失败时返回此前已提升的指令以及该指令的 *LiftError
+func (l *Lifter) LiftInstructions(mc []byte, insAddr uint64, n int) ([]InstructionIR, error) {
+	t := *l
+	t.opts.MaxInsns = 1
+	lookback := int(t.opts.LookbackAmount)
+	var insns []InstructionIR
+	for off := 0; lookback+off < len(mc) && (n <= 0 || len(insns) < n); {
+		// 从 mc[off:] 提升，使当前指令之前的 LookbackAmount 个字节仍可回看
+		addr := insAddr + uint64(off)
+		r, err := t.Lift(mc[off:], addr)
+		if err != nil {
+			return insns, err
+		}
+		ins := InstructionIR{
+			Addr:  addr,
+			Len:   r.Size,
+			Bytes: mc[lookback+off : lookback+off+r.Size],
+			Block: r.Block,
+		}
+		for i, s := range r.Block.Stmts {
+			if _, ok := s.(*IMarkStmt); ok {
+				ins.Stmts = r.Block.Stmts[i+1:]
+				break
+			}
+		}
+		insns = append(insns, ins)
+		off += r.Size
+	}
+	return insns, nil
+}
+
+// LiftInstructions 以架构的默认字节序逐条提升 code 中的指令，规则见 Lifter.LiftInstructions
+func LiftInstructions(arch VexArch, code []byte, addr uint64, n int) ([]InstructionIR, error) {
+	d := GetArchDescriptor(arch)
+	if d == nil {
+		return nil, &LiftError{Err: ErrUnsupportedArch, Arch: arch, Addr: addr}
+	}
+	return NewLifter(arch, d.DefaultEndness, nil).LiftInstructions(code, addr, n)
+}
@@ -0,0 +1,193 @@
+package vex_go
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestLifterConcurrent(t *testing.T) {
+	l := NewLifter(VexArchARM64, VexEndnessLE, nil)
+
+	const workers = 8
+	const perWorker = 200
+	var wg sync.WaitGroup
+	errs := make(chan string, workers)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < perWorker; i++ {
+				// add x<rd>, x<rd>, #imm; ret
+				rd := uint32(w)
+				imm := uint32(i & 0xfff)
+				mc := make([]byte, 8)
+				binary.LittleEndian.PutUint32(mc, 0x91000000|imm<<10|rd<<5|rd)
+				binary.LittleEndian.PutUint32(mc[4:], 0xd65f03c0)
+				addr := uint64(0x10000 + w*0x1000 + i*8)
+
+				err := l.Do(mc, addr, func(r *LiftResult) {
+					if r.Insts() != 2 || r.InstAddrs[0] != addr {
+						errs <- "unexpected instruction addresses"
+						return
+					}
+					imark := r.IRSB.GetStmt(0).AsIMark()
+					if uint64(imark.Addr) != addr {
+						errs <- "IRSB overwritten by another lift"
+					}
+				})
+				if err != nil {
+					errs <- err.Error()
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		t.Fatal(e)
+	}
+}
+
+func TestLiftBlock(t *testing.T) {
+	// add rax, 8; add rax, 8; ret; nop —— 在 ret 处结束
+	r, err := LiftBlock(VexArchAMD64, []byte{0x48, 0x83, 0xc0, 0x08, 0x48, 0x83, 0xc0, 0x08, 0xc3, 0x90}, 0x1000, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Size != 9 || !reflect.DeepEqual(r.InstAddrs, []uint64{0x1000, 0x1004, 0x1008}) ||
+		r.Block.JumpKind != IjkRet || r.Truncated {
+		t.Errorf("unexpected block: size %d insts %#x jk %v", r.Size, r.InstAddrs, r.Block.JumpKind)
+	}
+
+	opts := DefaultLiftOptions()
+	opts.MaxInsns = 2
+	if r, err = LiftBlock(VexArchAMD64, []byte{0x90, 0x90, 0x90, 0xc3}, 0x1000, opts); err != nil {
+		t.Fatal(err)
+	}
+	if r.Size != 2 || r.Insts() != 2 || !r.IsDefaultExitConstant || r.DefaultExit != 0x1002 {
+		t.Errorf("MaxInsns not honoured: size %d insts %d", r.Size, r.Insts())
+	}
+
+	// nop; add rax, 8; (bad) —— 0x06 在 64 位模式下无法解码
+	if r, err = LiftBlock(VexArchAMD64, []byte{0x90, 0x48, 0x83, 0xc0, 0x08, 0x06, 0xc3}, 0x1000, nil); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Truncated || r.Size != 5 || !reflect.DeepEqual(r.InstAddrs, []uint64{0x1000, 0x1001}) ||
+		r.Block.JumpKind != IjkBoring || r.DefaultExit != 0x1005 {
+		t.Errorf("unexpected truncated block: size %d insts %#x jk %v\n%s", r.Size, r.InstAddrs, r.Block.JumpKind, r.Block)
+	}
+	if diags := Validate(r.Block); diags != nil {
+		t.Errorf("truncated block is invalid: %v", diags)
+	}
+
+	// ARM: mov r0, r0; 无法解码的指令
+	r, err = LiftBlock(VexArchARM, []byte{0x00, 0x00, 0xa0, 0xe1, 0xff, 0xff, 0xff, 0xff}, 0x1000, nil)
+	if err != nil || !r.Truncated || r.Size != 4 || r.DefaultExit != 0x1004 {
+		t.Errorf("ARM truncation: %v %+v", err, r)
+	}
+
+	// 最后一条指令超出输入末尾：VEX 按补零后的字节解码，可能得到过长的指令（amd64）或无法解码的指令（arm64），
+	// 两种情况都应设置 Truncated
+	for _, c := range []struct {
+		arch VexArch
+		mc   []byte
+		size int
+	}{
+		{VexArchAMD64, []byte{0x90, 0x48, 0x89}, 1},                   // nop; mov 的前两个字节
+		{VexArchARM64, []byte{0xe2, 0x03, 0x00, 0xaa, 0xe3, 0x03}, 4}, // mov x2, x0; mov 的前两个字节
+	} {
+		r, err = LiftBlock(c.arch, c.mc, 0x1000, nil)
+		if err != nil || !r.Truncated || r.Size != c.size || r.Block.JumpKind != IjkBoring || r.DefaultExit != 0x1000+uint64(c.size) {
+			t.Errorf("%v: cut-off instruction: %v %+v", c.arch, err, r)
+		}
+	}
+	// 块因跳转或 MaxInsns 结束时，输入末尾的不完整指令不属于该块
+	opts.MaxInsns = 1
+	for _, c := range []struct {
+		mc   []byte
+		opts *LiftOptions
+	}{{[]byte{0xc3, 0x48, 0x89}, nil}, {[]byte{0x90, 0x48, 0x89}, opts}} {
+		if r, err = LiftBlock(VexArchAMD64, c.mc, 0x1000, c.opts); err != nil || r.Truncated || r.Size != 1 {
+			t.Errorf("%x: %v %+v", c.mc, err, r)
+		}
+	}
+
+	if _, err = LiftBlock(VexArchAMD64, []byte{0x06}, 0x1000, nil); !errors.Is(err, ErrDecode) {
+		t.Errorf("expected ErrDecode, got %v", err)
+	}
+	if _, err = LiftBlock(VexArchInvalid, []byte{0x90}, 0x1000, nil); !errors.Is(err, ErrUnsupportedArch) {
+		t.Errorf("expected ErrUnsupportedArch, got %v", err)
+	}
+}
+
+func TestLiftInstructions(t *testing.T) {
+	// mov rax, [rdi+8]; add rax, rsi; test rax, rax; jz 0; ret
+	mc := []byte{0x48, 0x8b, 0x47, 0x08, 0x48, 0x01, 0xf0, 0x48, 0x85, 0xc0, 0x74, 0xf4, 0xc3}
+	insns, err := LiftInstructions(VexArchAMD64, mc, 0x1000, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantLens := []int{4, 3, 3, 2, 1}
+	if len(insns) != len(wantLens) {
+		t.Fatalf("got %d instructions, want %d", len(insns), len(wantLens))
+	}
+	addr, off := uint64(0x1000), 0
+	for i, ins := range insns {
+		if ins.Addr != addr || ins.Len != wantLens[i] || string(ins.Bytes) != string(mc[off:off+ins.Len]) {
+			t.Errorf("insn %d: addr %#x len %d bytes % x", i, ins.Addr, ins.Len, ins.Bytes)
+		}
+		for _, s := range ins.Stmts {
+			if _, ok := s.(*IMarkStmt); ok {
+				t.Errorf("insn %d: IMark in statements", i)
+			}
+		}
+		if diags := Validate(ins.Block); diags != nil {
+			t.Errorf("insn %d: %v", i, diags)
+		}
+		addr += uint64(ins.Len)
+		off += ins.Len
+	}
+	// jz 之后继续提升 ret
+	if jk := insns[3].Block.JumpKind; jk != IjkBoring || insns[4].Block.JumpKind != IjkRet {
+		t.Errorf("unexpected jump kinds %v %v", jk, insns[4].Block.JumpKind)
+	}
+	if _, ok := insns[3].Stmts[len(insns[3].Stmts)-1].(*ExitStmt); !ok {
+		t.Errorf("jz should end with an exit: %v", insns[3].Stmts)
+	}
+	if !strings.Contains(insns[0].Block.String(), "LDle:I64") {
+		t.Errorf("mov should load:\n%s", insns[0].Block)
+	}
+
+	if insns, err = LiftInstructions(VexArchAMD64, mc, 0x1000, 2); err != nil || len(insns) != 2 {
+		t.Errorf("n=2: got %d instructions, %v", len(insns), err)
+	}
+
+	// nop; nop; (bad)
+	insns, err = LiftInstructions(VexArchAMD64, []byte{0x90, 0x90, 0x06, 0xc3}, 0x1000, 0)
+	var le *LiftError
+	if len(insns) != 2 || !errors.As(err, &le) || !errors.Is(err, ErrDecode) || le.Addr != 0x1002 {
+		t.Errorf("expected 2 instructions and ErrDecode at 0x1002, got %d, %v", len(insns), err)
+	}
+}
+
+func TestLifterInitError(t *testing.T) {
+	l := NewLifter(VexArchAMD64, VexEndnessLE, nil)
+	// 模拟 VexInit 失败
+	l.initErr = fmt.Errorf("%w: no memory", ErrInit)
+	err := l.Do([]byte{0x90}, 0x1000, func(*LiftResult) {
+		t.Error("callback called after failed init")
+	})
+	var le *LiftError
+	if !errors.Is(err, ErrInit) || !errors.As(err, &le) || le.Addr != 0x1000 {
+		t.Errorf("Do: got %v, want %v", err, ErrInit)
+	}
+	if _, err = l.LiftBlock([]byte{0x90}, 0x1000); !errors.Is(err, ErrInit) {
+		t.Errorf("LiftBlock: got %v, want %v", err, ErrInit)
+	}
+}
@@ -0,0 +1,239 @@
+package vex_go
+
+import "fmt"
+
+// 以下名称与 VEX 头文件中的枚举名一致
+
+var irStmtTagNames = map[IRStmtTag]string{
+	IstNoOp:    "Ist_NoOp",
+	IstIMark:   "Ist_IMark",
+	IstAbiHint: "Ist_AbiHint",
+	IstPut:     "Ist_Put",
+	IstPutI:    "Ist_PutI",
+	IstWrTmp:   "Ist_WrTmp",
+	IstStore:   "Ist_Store",
+	IstLoadG:   "Ist_LoadG",
+	IstStoreG:  "Ist_StoreG",
+	IstCAS:     "Ist_CAS",
+	IstLLSC:    "Ist_LLSC",
+	IstDirty:   "Ist_Dirty",
+	IstMBE:     "Ist_MBE",
+	IstExit:    "Ist_Exit",
+}
+
+var irExprTagNames = map[IRExprTag]string{
+	IexBinder: "Iex_Binder",
+	IexGet:    "Iex_Get",
+	IexGetI:   "Iex_GetI",
+	IexRdTmp:  "Iex_RdTmp",
+	IexQop:    "Iex_Qop",
+	IexTriop:  "Iex_Triop",
+	IexBinop:  "Iex_Binop",
+	IexUnop:   "Iex_Unop",
+	IexLoad:   "Iex_Load",
+	IexConst:  "Iex_Const",
+	IexITE:    "Iex_ITE",
+	IexCCall:  "Iex_CCall",
+	IexVECRET: "Iex_VECRET",
+	IexGSPTR:  "Iex_GSPTR",
+}
+
+var irTypeNames = map[IRType]string{
+	ItyINVALID: "Ity_INVALID",
+	ItyI1:      "Ity_I1",
+	ItyI8:      "Ity_I8",
+	ItyI16:     "Ity_I16",
+	ItyI32:     "Ity_I32",
+	ItyI64:     "Ity_I64",
+	ItyI128:    "Ity_I128",
+	ItyF16:     "Ity_F16",
+	ItyF32:     "Ity_F32",
+	ItyF64:     "Ity_F64",
+	ItyD32:     "Ity_D32",
+	ItyD64:     "Ity_D64",
+	ItyD128:    "Ity_D128",
+	ItyF128:    "Ity_F128",
+	ItyV128:    "Ity_V128",
+	ItyV256:    "Ity_V256",
+}
+
+var irConstTagNames = map[IRConstTag]string{
+	IcoU1:   "Ico_U1",
+	IcoU8:   "Ico_U8",
+	IcoU16:  "Ico_U16",
+	IcoU32:  "Ico_U32",
+	IcoU64:  "Ico_U64",
+	IcoF32:  "Ico_F32",
+	IcoF32i: "Ico_F32i",
+	IcoF64:  "Ico_F64",
+	IcoF64i: "Ico_F64i",
+	IcoV128: "Ico_V128",
+	IcoV256: "Ico_V256",
+}
+
+var irJumpKindNames = map[IRJumpKind]string{
+	IjkInvalid:      "Ijk_INVALID",
+	IjkBoring:       "Ijk_Boring",
+	IjkCall:         "Ijk_Call",
+	IjkRet:          "Ijk_Ret",
+	IjkClientReq:    "Ijk_ClientReq",
+	IjkYield:        "Ijk_Yield",
+	IjkEmWarn:       "Ijk_EmWarn",
+	IjkEmFail:       "Ijk_EmFail",
+	IjkNoDecode:     "Ijk_NoDecode",
+	IjkMapFail:      "Ijk_MapFail",
+	IjkInvalICache:  "Ijk_InvalICache",
+	IjkFlushDCache:  "Ijk_FlushDCache",
+	IjkNoRedir:      "Ijk_NoRedir",
+	IjkSigILL:       "Ijk_SigILL",
+	IjkSigTRAP:      "Ijk_SigTRAP",
+	IjkSigSEGV:      "Ijk_SigSEGV",
+	IjkSigBUS:       "Ijk_SigBUS",
+	IjkSigFPE:       "Ijk_SigFPE",
+	IjkSigFPEIntDiv: "Ijk_SigFPE_IntDiv",
+	IjkSigFPEIntOvf: "Ijk_SigFPE_IntOvf",
+	IjkPrivileged:   "Ijk_Privileged",
+	IjkSysSyscall:   "Ijk_Sys_syscall",
+	IjkSysInt:       "Ijk_Sys_int",
+	IjkSysInt32:     "Ijk_Sys_int32",
+	IjkSysInt128:    "Ijk_Sys_int128",
+	IjkSysInt129:    "Ijk_Sys_int129",
+	IjkSysInt130:    "Ijk_Sys_int130",
+	IjkSysInt145:    "Ijk_Sys_int145",
+	IjkSysInt210:    "Ijk_Sys_int210",
+	IjkSysSysenter:  "Ijk_Sys_sysenter",
+}
+
+var irEndnessNames = map[IREndness]string{
+	IendLE: "Iend_LE",
+	IendBE: "Iend_BE",
+}
+
+var irEffectNames = map[IREffect]string{
+	IfxNone:   "Ifx_None",
+	IfxRead:   "Ifx_Read",
+	IfxWrite:  "Ifx_Write",
+	IfxModify: "Ifx_Modify",
+}
+
+var irMBusEventNames = map[IRMBusEvent]string{
+	ImbeFence:             "Imbe_Fence",
+	ImbeCancelReservation: "Imbe_CancelReservation",
+}
+
+var irLoadGOpNames = map[IRLoadGOp]string{
+	ILGopInvalid:   "ILGop_INVALID",
+	ILGopIdentV128: "ILGop_IdentV128",
+	ILGopIdent64:   "ILGop_Ident64",
+	ILGopIdent32:   "ILGop_Ident32",
+	ILGop16Uto32:   "ILGop_16Uto32",
+	ILGop16Sto32:   "ILGop_16Sto32",
+	ILGop8Uto32:    "ILGop_8Uto32",
+	ILGop8Sto32:    "ILGop_8Sto32",
+}
+
+var vexArchNames = map[VexArch]string{
+	VexArchInvalid: "VexArch_INVALID",
+	VexArchX86:     "VexArchX86",
+	VexArchAMD64:   "VexArchAMD64",
+	VexArchARM:     "VexArchARM",
+	VexArchARM64:   "VexArchARM64",
+	VexArchPPC32:   "VexArchPPC32",
+	VexArchPPC64:   "VexArchPPC64",
+	VexArchS390X:   "VexArchS390X",
+	VexArchMIPS32:  "VexArchMIPS32",
+	VexArchMIPS64:  "VexArchMIPS64",
+	VexArchTILEGX:  "VexArchTILEGX",
+	VexArchRISCV64: "VexArchRISCV64",
+}
+
+var vexEndnessNames = map[VexEndness]string{
+	VexEndnessInvalid: "VexEndness_INVALID",
+	VexEndnessLE:      "VexEndnessLE",
+	VexEndnessBE:      "VexEndnessBE",
+}
+
+var vexRegisterUpdatesNames = map[VexRegisterUpdates]string{
+	VexRegUpdInvalid:               "VexRegUpd_INVALID",
+	VexRegUpdSpAtMemAccess:         "VexRegUpdSpAtMemAccess",
+	VexRegUpdUnwindregsAtMemAccess: "VexRegUpdUnwindregsAtMemAccess",
+	VexRegUpdAllregsAtMemAccess:    "VexRegUpdAllregsAtMemAccess",
+	VexRegUpdAllregsAtEachInsn:     "VexRegUpdAllregsAtEachInsn",
+	VexRegUpdLdAllregsAtEachInsn:   "VexRegUpdLdAllregsAtEachInsn",
+}
+
+var dataRefTypeNames = map[DataRefType]string{
+	DtUnknown:      "Dt_Unknown",
+	DtInteger:      "Dt_Integer",
+	DtFP:           "Dt_FP",
+	DtStoreInteger: "Dt_StoreInteger",
+}
+
+func enumString[T ~uint32](names map[T]string, v T, typ string) string {
+	if s, ok := names[v]; ok {
+		return s
+	}
+	return fmt.Sprintf("%s(%#x)", typ, uint32(v))
+}
+
+// enumParse 是 enumString 的逆操作
+func enumParse[T ~uint32](names map[T]string, s, typ string) (T, error) {
+	for v, name := range names {
+		if name == s {
+			return v, nil
+		}
+	}
+	return 0, fmt.Errorf("vex: unknown %s %q", typ, s)
+}
+
+func (v IRStmtTag) String() string {
+	return enumString(irStmtTagNames, v, "IRStmtTag")
+}
+
+func (v IRExprTag) String() string {
+	return enumString(irExprTagNames, v, "IRExprTag")
+}
+
+func (v IRType) String() string {
+	return enumString(irTypeNames, v, "IRType")
+}
+
+func (v IRConstTag) String() string {
+	return enumString(irConstTagNames, v, "IRConstTag")
+}
+
+func (v IRJumpKind) String() string {
+	return enumString(irJumpKindNames, v, "IRJumpKind")
+}
+
+func (v IREndness) String() string {
+	return enumString(irEndnessNames, v, "IREndness")
+}
+
+func (v IREffect) String() string {
+	return enumString(irEffectNames, v, "IREffect")
+}
+
+func (v IRMBusEvent) String() string {
+	return enumString(irMBusEventNames, v, "IRMBusEvent")
+}
+
+func (v IRLoadGOp) String() string {
+	return enumString(irLoadGOpNames, v, "IRLoadGOp")
+}
+
+func (v VexArch) String() string {
+	return enumString(vexArchNames, v, "VexArch")
+}
+
+func (v VexEndness) String() string {
+	return enumString(vexEndnessNames, v, "VexEndness")
+}
+
+func (v VexRegisterUpdates) String() string {
+	return enumString(vexRegisterUpdatesNames, v, "VexRegisterUpdates")
+}
+
+func (v DataRefType) String() string {
+	return enumString(dataRefTypeNames, v, "DataRefType")
+}
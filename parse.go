@@ -0,0 +1,824 @@
+package vex_go
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// 本文件解析 print.go 输出的文本 IR，既接受 ppIRSB 的格式，也接受 Block.Fprint 的格式
+// （语句下标、寄存器名、NEXT 行），便于手写测试用例：
+//
+//	IRSB {
+//	   t0:I64   t1:I64
+//
+//	   t0 = GET:I64(rdi)
+//	   t1 = Add64(t0,0x8:I64)
+//	   if (t2) { PUT(rip) = 0x1000; Ijk_Boring }
+//	   NEXT: PUT(rip) = t1; Ijk_Boring
+//	}
+//
+// 外层的 "IRSB {" 与 "}" 可以省略；未在类型环境中声明的 WrTmp/LoadG 目标由写入的值推导类型。
+// NEXT 与 Exit 中的常量可以不带类型，此时使用架构的字长。
+
+// irParser 解析一行文本
+type irParser struct {
+	arch VexArch
+	src  string
+	pos  int
+	line int
+}
+
+// maxParseTemps 是文本中临时变量编号的上限。类型环境按编号分配，上限防止 t4294967294 之类的编号
+// 分配巨大的切片；VEX 单个块的临时变量远少于此
+const maxParseTemps = 1 << 20
+
+// parseAbort 用于在出错时跳出递归下降
+type parseAbort struct{ err *ParseError }
+
+func (p *irParser) fail(format string, args ...any) {
+	p.failAt(p.pos, format, args...)
+}
+
+// failAt 与 fail 相同，但报告 pos 处的列号
+func (p *irParser) failAt(pos int, format string, args ...any) {
+	panic(parseAbort{&ParseError{Line: p.line, Col: pos + 1, Msg: fmt.Sprintf(format, args...)}})
+}
+
+// run 执行 fn 并把其中的 fail 转换为错误
+func (p *irParser) run(fn func()) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			a, ok := r.(parseAbort)
+			if !ok {
+				panic(r)
+			}
+			err = a.err
+		}
+	}()
+	fn()
+	return nil
+}
+
+func (p *irParser) ws() {
+	for p.pos < len(p.src) && (p.src[p.pos] == ' ' || p.src[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+// lit 跳过空白后尝试匹配 s
+func (p *irParser) lit(s string) bool {
+	p.ws()
+	if strings.HasPrefix(p.src[p.pos:], s) {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *irParser) expect(s string) {
+	if !p.lit(s) {
+		p.fail("expected %q", s)
+	}
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// peekIdent 返回当前位置的标识符但不消耗
+func (p *irParser) peekIdent() string {
+	p.ws()
+	end := p.pos
+	for end < len(p.src) && isIdentChar(p.src[end]) {
+		end++
+	}
+	return p.src[p.pos:end]
+}
+
+func (p *irParser) ident() string {
+	id := p.peekIdent()
+	if id == "" {
+		p.fail("expected identifier")
+	}
+	p.pos += len(id)
+	return id
+}
+
+// keyword 匹配完整的标识符 s
+func (p *irParser) keyword(s string) bool {
+	if p.peekIdent() == s {
+		p.pos += len(s)
+		return true
+	}
+	return false
+}
+
+func (p *irParser) peekByte() byte {
+	p.ws()
+	if p.pos < len(p.src) {
+		return p.src[p.pos]
+	}
+	return 0
+}
+
+func (p *irParser) uint(bits int) uint64 {
+	p.ws()
+	start := p.pos
+	id := p.peekIdent()
+	v, err := strconv.ParseUint(id, 0, bits)
+	if id == "" || err != nil {
+		p.fail("expected %d-bit unsigned number", bits)
+	}
+	p.pos = start + len(id)
+	return v
+}
+
+func (p *irParser) int32() int32 {
+	neg := p.lit("-")
+	v := p.uint(32)
+	if neg {
+		if v > 1<<31 {
+			p.fail("number out of range")
+		}
+		return int32(-int64(v))
+	}
+	if v > 1<<31-1 {
+		p.fail("number out of range")
+	}
+	return int32(v)
+}
+
+func (p *irParser) end() {
+	p.ws()
+	if p.pos != len(p.src) {
+		p.fail("unexpected %q", p.src[p.pos:])
+	}
+}
+
+// parsePrinted 根据 pp 输出的名称或 VEX 枚举名查找枚举值
+func parsePrinted[T ~uint32](names map[T]string, s string, pp func(T) string) (T, bool) {
+	for v, name := range names {
+		if s == name || s == pp(v) {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+func (p *irParser) ty() IRType {
+	name := p.ident()
+	ty, ok := parsePrinted(irTypeNames, name, ppType)
+	if !ok {
+		p.fail("unknown type %q", name)
+	}
+	return ty
+}
+
+func (p *irParser) endness() IREndness {
+	if p.lit("le") {
+		return IendLE
+	}
+	if p.lit("be") {
+		return IendBE
+	}
+	p.fail("expected le or be")
+	return 0
+}
+
+func (p *irParser) effect() IREffect {
+	name := p.ident()
+	fx, ok := parsePrinted(irEffectNames, name, ppEffect)
+	if !ok {
+		p.fail("unknown effect %q", name)
+	}
+	return fx
+}
+
+// jumpKind 解析 exit-Boring 中的 Boring 或 Ijk_Boring
+func (p *irParser) jumpKind() IRJumpKind {
+	name := p.ident()
+	jk, ok := parsePrinted(irJumpKindNames, name, ppJumpKind)
+	if !ok {
+		p.fail("unknown jump kind %q", name)
+	}
+	return jk
+}
+
+func (p *irParser) temp() IRTemp {
+	p.ws()
+	id := p.peekIdent()
+	if id == "IRTemp_INVALID" {
+		p.pos += len(id)
+		return IRTempInvalid
+	}
+	if len(id) < 2 || id[0] != 't' {
+		p.fail("expected temporary")
+	}
+	n, err := strconv.ParseUint(id[1:], 10, 32)
+	if err != nil || IRTemp(n) == IRTempInvalid {
+		p.fail("bad temporary %q", id)
+	}
+	if n >= maxParseTemps {
+		p.fail("temporary %q out of range", id)
+	}
+	p.pos += len(id)
+	return IRTemp(n)
+}
+
+// isTemp 判断当前位置是否为 tN 形式的临时变量
+func (p *irParser) isTemp() bool {
+	id := p.peekIdent()
+	if len(id) < 2 || id[0] != 't' {
+		return false
+	}
+	_, err := strconv.ParseUint(id[1:], 10, 32)
+	return err == nil
+}
+
+// guestOffset 解析 Get/Put/Exit 中的偏移，可以是数字、寄存器名或 "rax+2" 形式
+func (p *irParser) guestOffset() int32 {
+	c := p.peekByte()
+	if c >= '0' && c <= '9' {
+		return p.int32()
+	}
+	start := p.pos
+	name := p.ident()
+	off, ok := RegisterOffset(p.arch, name)
+	if !ok {
+		p.failAt(start, "unknown register %q for %v", name, p.arch)
+	}
+	if p.lit("+") {
+		off += p.int32()
+	}
+	return off
+}
+
+// wordType 返回架构字长对应的整数类型，用于不带类型的常量
+func (p *irParser) wordType(pos int) IRType {
+	d := GetArchDescriptor(p.arch)
+	if d == nil {
+		p.failAt(pos, "untyped constant needs a known architecture")
+	}
+	if d.WordSize == 4 {
+		return ItyI32
+	}
+	return ItyI64
+}
+
+var constTagByType = map[IRType]IRConstTag{
+	ItyI1: IcoU1, ItyI8: IcoU8, ItyI16: IcoU16, ItyI32: IcoU32, ItyI64: IcoU64,
+}
+
+var wideConstTags = map[string]IRConstTag{
+	"F32": IcoF32, "F32i": IcoF32i, "F64": IcoF64, "F64i": IcoF64i, "V128": IcoV128, "V256": IcoV256,
+}
+
+// constant 解析常量；untyped 为 true 时允许省略 ":Ty"，使用架构字长
+func (p *irParser) constant(untyped bool) Constant {
+	if id := p.peekIdent(); wideConstTags[id] != 0 {
+		p.pos += len(id)
+		p.expect("{")
+		tag := wideConstTags[id]
+		// V128/V256 常量是逐字节的掩码，只有 16/32 位
+		bits := map[IRConstTag]int{IcoV128: 16, IcoV256: 32}[tag]
+		if bits == 0 {
+			bits = GetIRConstTagSize(tag) * 8
+		}
+		c := Constant{Tag: tag, Bits: p.uint(bits)}
+		p.expect("}")
+		return c
+	}
+	start := p.pos
+	bits := p.uint(64)
+	var ty IRType
+	if p.lit(":") {
+		ty = p.ty()
+	} else if untyped {
+		ty = p.wordType(start)
+	} else {
+		p.fail("constant needs a type")
+	}
+	tag, ok := constTagByType[ty]
+	if !ok {
+		p.fail("bad constant type %v", ty)
+	}
+	size := GetIRConstTagSize(tag) * 8
+	if tag == IcoU1 {
+		size = 1
+	}
+	if size < 64 && bits>>size != 0 {
+		p.fail("constant %#x does not fit %v", bits, ty)
+	}
+	return Constant{Tag: tag, Bits: bits}
+}
+
+// callee 解析 name[rp=N][mcx=0xN]{0xADDR}，方括号与花括号部分均可省略
+func (p *irParser) callee() Callee {
+	c := Callee{Name: p.ident()}
+	if p.lit("[rp=") {
+		c.Regparms = p.int32()
+		p.expect("]")
+	}
+	if p.lit("[mcx=") {
+		c.McxMask = uint32(p.uint(32))
+		p.expect("]")
+	}
+	if p.lit("{") {
+		c.Addr = uintptr(p.uint(64))
+		p.expect("}")
+	}
+	return c
+}
+
+// regArray 解析 (base:nElemsxTy)
+func (p *irParser) regArray() RegArray {
+	p.expect("(")
+	r := RegArray{Base: p.int32()}
+	p.expect(":")
+	// nElems 与类型之间没有分隔，如 8xI64
+	p.ws()
+	id := p.ident()
+	i := strings.IndexByte(id, 'x')
+	if i <= 0 {
+		p.fail("bad register array %q", id)
+	}
+	n, err := strconv.ParseInt(id[:i], 10, 32)
+	ty, ok := parsePrinted(irTypeNames, id[i+1:], ppType)
+	if err != nil || !ok {
+		p.fail("bad register array %q", id)
+	}
+	r.NElems, r.ElemTy = int32(n), ty
+	p.expect(")")
+	return r
+}
+
+func (p *irParser) args() []Expr {
+	p.expect("(")
+	var args []Expr
+	if p.lit(")") {
+		return args
+	}
+	for {
+		args = append(args, p.expr())
+		if p.lit(")") {
+			return args
+		}
+		p.expect(",")
+	}
+}
+
+func (p *irParser) expr() Expr {
+	p.ws()
+	switch {
+	case p.lit("GETI"):
+		e := &GetIExpr{Descr: p.regArray()}
+		p.expect("[")
+		e.Ix = p.expr()
+		p.expect(",")
+		e.Bias = p.int32()
+		p.expect("]")
+		return e
+	case p.lit("GET:"):
+		e := &GetExpr{Ty: p.ty()}
+		p.expect("(")
+		e.Offset = p.guestOffset()
+		p.expect(")")
+		return e
+	case strings.HasPrefix(p.src[p.pos:], "LDle:") || strings.HasPrefix(p.src[p.pos:], "LDbe:"):
+		p.pos += len("LD")
+		e := &LoadExpr{End: p.endness()}
+		p.expect(":")
+		e.Ty = p.ty()
+		p.expect("(")
+		e.Addr = p.expr()
+		p.expect(")")
+		return e
+	case p.lit("BIND-"):
+		return &BinderExpr{Binder: p.int32()}
+	case p.keyword("GSPTR"):
+		return &GSPTRExpr{}
+	case p.keyword("VECRET"):
+		return &VECRETExpr{}
+	case p.isTemp():
+		return &RdTmpExpr{Tmp: p.temp()}
+	}
+	c := p.peekByte()
+	id := p.peekIdent()
+	switch {
+	case c >= '0' && c <= '9' && (strings.HasPrefix(id, "0x") || p.afterIdent(id) == ':'):
+		return &ConstExpr{Con: p.constant(false)}
+	case wideConstTags[id] != 0 && p.afterIdent(id) == '{':
+		return &ConstExpr{Con: p.constant(false)}
+	case id == "ITE" && p.afterIdent(id) == '(':
+		p.pos += len(id)
+		args := p.args()
+		if len(args) != 3 {
+			p.fail("ITE takes 3 args, got %d", len(args))
+		}
+		return &ITEExpr{Cond: args[0], IfTrue: args[1], IfFalse: args[2]}
+	case id == "":
+		p.fail("expected expression")
+	}
+	if op, ok := ParseIROp(id); ok && p.afterIdent(id) == '(' {
+		start := p.pos
+		p.pos += len(id)
+		args := p.args()
+		if n := op.Arity(); len(args) != n {
+			p.failAt(start, "%v takes %d args, got %d", op, n, len(args))
+		}
+		switch len(args) {
+		case 1:
+			return &UnopExpr{Op: op, Arg: args[0]}
+		case 2:
+			return &BinopExpr{Op: op, Arg1: args[0], Arg2: args[1]}
+		case 3:
+			return &TriopExpr{Op: op, Arg1: args[0], Arg2: args[1], Arg3: args[2]}
+		default:
+			return &QopExpr{Op: op, Arg1: args[0], Arg2: args[1], Arg3: args[2], Arg4: args[3]}
+		}
+	}
+	e := &CCallExpr{Cee: p.callee()}
+	e.Args = p.args()
+	p.expect(":")
+	e.RetTy = p.ty()
+	return e
+}
+
+// afterIdent 返回紧跟在标识符 id 之后的字符
+func (p *irParser) afterIdent(id string) byte {
+	if i := p.pos + len(id); i < len(p.src) {
+		return p.src[i]
+	}
+	return 0
+}
+
+func (p *irParser) stmt() Stmt {
+	p.ws()
+	switch {
+	case p.lit("IR-NoOp"):
+		return &NoOpStmt{}
+	case p.lit("IR-"):
+		name := p.ident()
+		ev, ok := parsePrinted(irMBusEventNames, name, func(v IRMBusEvent) string {
+			return strings.TrimPrefix(v.String(), "Imbe_")
+		})
+		if !ok {
+			p.fail("unknown memory bus event %q", name)
+		}
+		return &MBEStmt{Event: ev}
+	case p.lit("------"):
+		p.expect("IMark(")
+		s := &IMarkStmt{Addr: p.uint(64)}
+		p.expect(",")
+		s.Len = uint32(p.uint(32))
+		p.expect(",")
+		s.Delta = uint8(p.uint(8))
+		p.expect(")")
+		p.expect("------")
+		return s
+	case p.lit("======"):
+		p.expect("AbiHint(")
+		s := &AbiHintStmt{Base: p.expr()}
+		p.expect(",")
+		s.Len = p.int32()
+		p.expect(",")
+		s.Nia = p.expr()
+		p.expect(")")
+		p.expect("======")
+		return s
+	case p.lit("PUTI"):
+		s := &PutIStmt{Descr: p.regArray()}
+		p.expect("[")
+		s.Ix = p.expr()
+		p.expect(",")
+		s.Bias = p.int32()
+		p.expect("]")
+		p.expect("=")
+		s.Data = p.expr()
+		return s
+	case p.lit("PUT("):
+		s := &PutStmt{Offset: p.guestOffset()}
+		p.expect(")")
+		p.expect("=")
+		s.Data = p.expr()
+		return s
+	case p.lit("ST"):
+		s := &StoreStmt{End: p.endness()}
+		p.expect("(")
+		s.Addr = p.expr()
+		p.expect(")")
+		p.expect("=")
+		s.Data = p.expr()
+		return s
+	case p.lit("if"):
+		return p.guarded()
+	case p.keyword("DIRTY"):
+		return p.dirty(IRTempInvalid)
+	}
+	if !p.isTemp() {
+		p.fail("expected statement")
+	}
+	dst := p.temp()
+	if p.lit(",") {
+		return p.cas(dst, p.temp())
+	}
+	p.expect("=")
+	switch {
+	case p.lit("if-strict"):
+		p.expect("(")
+		s := &LoadGStmt{Dst: dst, Guard: p.expr()}
+		p.expect(")")
+		name := p.ident()
+		cvt, ok := parsePrinted(irLoadGOpNames, name, ppLoadGOp)
+		if !ok {
+			p.fail("unknown LoadG conversion %q", name)
+		}
+		s.Cvt = cvt
+		p.expect("(")
+		p.expect("LD")
+		s.End = p.endness()
+		p.expect("(")
+		s.Addr = p.expr()
+		p.expect(")")
+		p.expect(")")
+		p.expect("else")
+		s.Alt = p.expr()
+		return s
+	case p.keyword("DIRTY"):
+		return p.dirty(dst)
+	case p.lit("CAS"):
+		p.pos -= len("CAS")
+		return p.cas(IRTempInvalid, dst)
+	case p.lit("("):
+		p.expect("ST")
+		s := &LLSCStmt{Result: dst, End: p.endness()}
+		p.expect("-Cond(")
+		s.Addr = p.expr()
+		p.expect(")")
+		p.expect("=")
+		s.StoreData = p.expr()
+		p.expect(")")
+		return s
+	}
+	// LDle-Linked 与 LDle:Ty 共享前缀
+	if save := p.pos; p.lit("LD") {
+		end := p.endness()
+		if p.lit("-Linked(") {
+			s := &LLSCStmt{Result: dst, End: end, Addr: p.expr()}
+			p.expect(")")
+			return s
+		}
+		p.pos = save
+	}
+	return &WrTmpStmt{Tmp: dst, Data: p.expr()}
+}
+
+// cas 解析 "CASle(addr::[expdHi,]expdLo->[dataHi,]dataLo)"
+func (p *irParser) cas(oldHi, oldLo IRTemp) Stmt {
+	if oldHi != IRTempInvalid {
+		p.expect("=")
+	}
+	p.expect("CAS")
+	s := &CASStmt{OldHi: oldHi, OldLo: oldLo, End: p.endness()}
+	p.expect("(")
+	s.Addr = p.expr()
+	p.expect("::")
+	s.ExpdLo = p.expr()
+	if p.lit(",") {
+		s.ExpdHi, s.ExpdLo = s.ExpdLo, p.expr()
+	}
+	p.expect("->")
+	s.DataLo = p.expr()
+	if p.lit(",") {
+		s.DataHi, s.DataLo = s.DataLo, p.expr()
+	}
+	p.expect(")")
+	if (s.OldHi == IRTempInvalid) != (s.ExpdHi == nil) || (s.ExpdHi == nil) != (s.DataHi == nil) {
+		p.fail("CAS mixes single and double element forms")
+	}
+	return s
+}
+
+// dirty 解析 "DIRTY guard [XX-mem(addr,size)] [XX-gst(off,size[,repsN,stepM])]... ::: callee(args)"
+func (p *irParser) dirty(tmp IRTemp) Stmt {
+	s := &DirtyStmt{Tmp: tmp, Guard: p.expr(), MFx: IfxNone}
+	for !p.lit(":::") {
+		fx := p.effect()
+		switch {
+		case p.lit("-mem("):
+			s.MFx = fx
+			s.MAddr = p.expr()
+			p.expect(",")
+			s.MSize = p.int32()
+		case p.lit("-gst("):
+			f := FxState{Fx: fx, Offset: uint16(p.guestOffset())}
+			p.expect(",")
+			f.Size = uint16(p.uint(16))
+			if p.lit(",reps") {
+				f.NRepeats = uint8(p.uint(8))
+				p.expect(",step")
+				f.RepeatLen = uint8(p.uint(8))
+			}
+			s.FxState = append(s.FxState, f)
+		default:
+			p.fail("expected -mem or -gst")
+		}
+		p.expect(")")
+	}
+	s.Cee = p.callee()
+	s.Args = p.args()
+	return s
+}
+
+// guarded 解析 if 开头的 StoreG 与 Exit
+func (p *irParser) guarded() Stmt {
+	p.expect("(")
+	guard := p.expr()
+	p.expect(")")
+	p.expect("{")
+	if p.lit("ST") {
+		s := &StoreGStmt{Guard: guard, End: p.endness()}
+		p.expect("(")
+		s.Addr = p.expr()
+		p.expect(")")
+		p.expect("=")
+		s.Data = p.expr()
+		p.expect("}")
+		return s
+	}
+	p.expect("PUT(")
+	s := &ExitStmt{Guard: guard, OffsIP: p.guestOffset()}
+	p.expect(")")
+	p.expect("=")
+	s.Dst = p.constant(true)
+	p.expect(";")
+	s.Jk = p.exitJumpKind()
+	p.expect("}")
+	return s
+}
+
+// exitJumpKind 解析 "exit-Boring" 或 "Ijk_Boring"
+func (p *irParser) exitJumpKind() IRJumpKind {
+	p.lit("exit-")
+	return p.jumpKind()
+}
+
+// next 解析 "PUT(off) = expr; exit-Jk" 或 "NEXT: PUT(off) = expr; Ijk_Jk"
+func (p *irParser) next(b *Block) {
+	p.lit("NEXT:")
+	p.expect("PUT(")
+	b.OffsIP = p.guestOffset()
+	p.expect(")")
+	p.expect("=")
+	c := p.peekByte()
+	if c >= '0' && c <= '9' {
+		b.Next = &ConstExpr{Con: p.constant(true)}
+	} else {
+		b.Next = p.expr()
+	}
+	p.expect(";")
+	b.JumpKind = p.exitJumpKind()
+}
+
+var (
+	stmtIndexPattern = regexp.MustCompile(`^\d+ \| `)
+	typeEnvPattern   = regexp.MustCompile(`^t\d+:`)
+)
+
+// ParseIRSB 解析 ppIRSB 或 Block.Fprint 格式的文本。arch 用于解析寄存器名与不带类型的常量，
+// 文本中只有数字偏移与带类型的常量时可以为 VexArchInvalid。错误为 *ParseError。
+// 临时变量编号必须小于 maxParseTemps
+func ParseIRSB(arch VexArch, text string) (*Block, error) {
+	b := &Block{Arch: arch}
+	var env []IRType
+	var stmtLines []int
+	lines := strings.Split(text, "\n")
+	wrapped, closed, done := false, false, false
+	p := &irParser{arch: arch}
+	for i, raw := range lines {
+		line := strings.TrimSpace(raw)
+		if line == "" {
+			continue
+		}
+		line = stmtIndexPattern.ReplaceAllString(line, "")
+		p.src, p.pos, p.line = line, 0, i+1
+		err := p.run(func() {
+			switch {
+			case closed:
+				p.fail("text after closing brace")
+			case line == "IRSB {":
+				if wrapped || done || len(b.Stmts) > 0 || len(env) > 0 {
+					p.fail("unexpected IRSB header")
+				}
+				wrapped = true
+			case line == "}":
+				if !wrapped {
+					p.fail("unexpected closing brace")
+				}
+				closed = true
+			case done:
+				p.fail("statement after block exit")
+			case typeEnvPattern.MatchString(line) && len(b.Stmts) == 0:
+				for p.pos < len(p.src) {
+					t := p.temp()
+					p.expect(":")
+					for int(t) >= len(env) {
+						env = append(env, ItyINVALID)
+					}
+					env[t] = p.ty()
+					p.ws()
+				}
+			case strings.HasPrefix(line, "NEXT:") || strings.Contains(line, "; exit-") && strings.HasPrefix(line, "PUT("):
+				p.next(b)
+				p.end()
+				done = true
+			default:
+				b.Stmts = append(b.Stmts, p.stmt())
+				stmtLines = append(stmtLines, p.line)
+				p.end()
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if wrapped && !closed {
+		return nil, &ParseError{Line: len(lines), Col: 1, Msg: "missing closing brace"}
+	}
+	if !done {
+		return nil, &ParseError{Line: len(lines), Col: 1, Msg: "missing block exit (NEXT: PUT(...) = ...; Ijk_...)"}
+	}
+	env, err := inferTypeEnv(env, b.Stmts, stmtLines)
+	if err != nil {
+		return nil, err
+	}
+	b.TypeEnv = env
+	return b, nil
+}
+
+// inferTypeEnv 在声明的类型环境上补全 WrTmp、LoadG、LLSC 等语句写入的临时变量类型。
+// lines 为每条语句所在的行号，用于报告错误
+func inferTypeEnv(env []IRType, stmts []Stmt, lines []int) ([]IRType, error) {
+	set := func(t IRTemp, ty IRType) {
+		for int(t) >= len(env) {
+			env = append(env, ItyINVALID)
+		}
+		env[t] = ty
+	}
+	for i, s := range stmts {
+		dst, ty := IRTempInvalid, ItyINVALID
+		switch s := s.(type) {
+		case *WrTmpStmt:
+			dst, ty = s.Tmp, typeOfExpr(env, s.Data)
+		case *LoadGStmt:
+			dst = s.Dst
+			ty, _ = typeOfLoadGOp(s.Cvt)
+		case *CASStmt:
+			dst, ty = s.OldLo, typeOfExpr(env, s.ExpdLo)
+			if s.OldHi != IRTempInvalid && (int(s.OldHi) >= len(env) || env[s.OldHi] == ItyINVALID) {
+				set(s.OldHi, ty)
+			}
+		case *LLSCStmt:
+			dst = s.Result
+			if s.StoreData != nil {
+				ty = ItyI1
+			}
+		case *DirtyStmt:
+			dst = s.Tmp
+		default:
+			continue
+		}
+		if dst == IRTempInvalid || int(dst) < len(env) && env[dst] != ItyINVALID {
+			continue
+		}
+		if ty == ItyINVALID {
+			return nil, &ParseError{Line: lines[i], Col: 1, Msg: fmt.Sprintf("type of %v must be declared", dst)}
+		}
+		set(dst, ty)
+	}
+	return env, nil
+}
+
+// ParseStmt 解析单条语句，格式与 Stmt.String() 一致
+func ParseStmt(arch VexArch, text string) (Stmt, error) {
+	p := &irParser{arch: arch, src: strings.TrimSpace(text), line: 1}
+	var s Stmt
+	err := p.run(func() {
+		s = p.stmt()
+		p.end()
+	})
+	return s, err
+}
+
+// ParseExpr 解析单个表达式，格式与 Expr.String() 一致
+func ParseExpr(arch VexArch, text string) (Expr, error) {
+	p := &irParser{arch: arch, src: strings.TrimSpace(text), line: 1}
+	var e Expr
+	err := p.run(func() {
+		e = p.expr()
+		p.end()
+	})
+	return e, err
+}
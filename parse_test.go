@@ -0,0 +1,117 @@
+package vex_go
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseIRSBRoundTrip(t *testing.T) {
+	for _, blk := range liftTestBlocks(t) {
+		var sb strings.Builder
+		if err := blk.Fprint(&sb, DefaultPrintOptions()); err != nil {
+			t.Fatal(err)
+		}
+		for _, text := range []string{blk.String(), sb.String()} {
+			b, err := ParseIRSB(blk.Arch, text)
+			if err != nil {
+				t.Fatalf("%v: %v\n%s", blk.Arch, err, text)
+			}
+			if !reflect.DeepEqual(b, blk) {
+				t.Errorf("%v: round trip mismatch:\n%s\n%s", blk.Arch, b, blk)
+			}
+		}
+	}
+}
+
+func TestParseIRSB(t *testing.T) {
+	text := `
+   t0:I64
+   ------ IMark(0x1000, 4, 0) ------
+   t1 = GET:I64(rdi)
+   t2 = Add64(t1,0x8:I64)
+   STle(t2) = t0
+   t3 = LDle:I32(t2)
+   t5 = CmpEQ64(t1,0x0:I64)
+   if (t5) { PUT(rip) = 0x1000; Ijk_Boring }
+   PUT(rax+4) = t3
+   NEXT: PUT(rip) = t2; Ijk_Ret
+`
+	b, err := ParseIRSB(VexArchAMD64, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := []IRType{ItyI64, ItyI64, ItyI64, ItyI32, ItyINVALID, ItyI1}
+	if !reflect.DeepEqual(b.TypeEnv, env) {
+		t.Errorf("type env %v, want %v", b.TypeEnv, env)
+	}
+	if len(b.Stmts) != 8 || b.JumpKind != IjkRet || b.OffsIP != 184 {
+		t.Fatalf("unexpected block:\n%s", b)
+	}
+	exit := b.Stmts[6].(*ExitStmt)
+	if exit.Dst != (Constant{Tag: IcoU64, Bits: 0x1000}) || exit.OffsIP != 184 || exit.Jk != IjkBoring {
+		t.Errorf("unexpected exit %s", exit)
+	}
+	if put := b.Stmts[7].(*PutStmt); put.Offset != 20 {
+		t.Errorf("PUT(rax+4) offset %d, want 20", put.Offset)
+	}
+
+	// ARM 的字长为 4，不带类型的常量为 I32
+	b, err = ParseIRSB(VexArchARM, "if (1:I1) { PUT(pc) = 0x1000; Ijk_Boring }\nNEXT: PUT(pc) = 0x2000; Ijk_Boring")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := b.Next.(*ConstExpr).Con; c != (Constant{Tag: IcoU32, Bits: 0x2000}) {
+		t.Errorf("untyped next %v, want 0x2000:I32", c)
+	}
+
+	// 手写的编号不必连续，能否解析与临时变量出现的次数无关
+	b, err = ParseIRSB(VexArchInvalid, "t57:I64\nt57 = GET:I64(16)\nNEXT: PUT(184) = t57; Ijk_Boring")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(b.TypeEnv) != 58 || b.TypeEnv[57] != ItyI64 {
+		t.Errorf("type env %v, want t57:I64", b.TypeEnv)
+	}
+
+	s, err := ParseStmt(VexArchInvalid, "t1,t0 = CASle(t2::t3,t4->t5,t6)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "t1,t0 = CASle(t2::t3,t4->t5,t6)"; s.String() != want {
+		t.Errorf("got %q, want %q", s, want)
+	}
+	e, err := ParseExpr(VexArchInvalid, "amd64g_calculate_condition[mcx=0x13]{0x0}(0x4:I64,GET:I64(144)):I64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cc, ok := e.(*CCallExpr); !ok || cc.Cee.Name != "amd64g_calculate_condition" || cc.Cee.McxMask != 0x13 {
+		t.Errorf("unexpected callee %s", e)
+	}
+}
+
+func TestParseIRSBErrors(t *testing.T) {
+	tests := []struct {
+		text      string
+		line, col int
+		msg       string
+	}{
+		{"t0 = GET:I64(16)\nt1 = Add64(t0)\nNEXT: PUT(184) = t1; Ijk_Boring", 2, 6, "takes 2 args"},
+		{"t0 = GET:I64(foo)\nNEXT: PUT(184) = t0; Ijk_Boring", 1, 14, `unknown register "foo"`},
+		{"t0 = GET:I64(16)\nNEXT: PUT(184) = 0x1000; Ijk_Boring", 2, 18, "needs a known architecture"},
+		{"t0 = GET:I64(16)", 1, 1, "missing block exit"},
+		{"t0 = LDle-Linked(t1)\nNEXT: PUT(184) = t0; Ijk_Boring", 1, 1, "type of t0 must be declared"},
+		{"IRSB {\nNEXT: PUT(184) = 0x0:I64; Ijk_Boring\nt0 = t1\n}", 3, 1, "after block exit"},
+		{"IRSB {\n   t0:I64 t99999999:I64\n   NEXT: PUT(184) = t0; Ijk_Boring\n}", 2, 8, "out of range"},
+		{"t4294967294 = GET:I64(16)\nNEXT: PUT(184) = 0x0:I64; Ijk_Boring", 1, 1, "out of range"},
+		{"t1048576 = GET:I64(16)\nNEXT: PUT(184) = 0x0:I64; Ijk_Boring", 1, 1, "out of range"},
+	}
+	for _, tt := range tests {
+		_, err := ParseIRSB(VexArchInvalid, tt.text)
+		var pe *ParseError
+		if !errors.As(err, &pe) || pe.Line != tt.line || pe.Col != tt.col || !strings.Contains(pe.Msg, tt.msg) {
+			t.Errorf("%q: got %v, want %d:%d %s", tt.text, err, tt.line, tt.col, tt.msg)
+		}
+	}
+}
@@ -0,0 +1,456 @@
+package vex_go
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// 本文件按 VEX ir_defs.c 中 ppIRStmt/ppIRExpr/ppIRSB 的格式打印 IR
+
+// irPrinter 将 IR 节点以 VEX 的文本格式写入缓冲区
+type irPrinter struct {
+	strings.Builder
+	layout *GuestLayout // 非 nil 时以寄存器名代替 Get/Put/Exit 及 Dirty 客户机状态访问中的偏移
+	env    []IRType     // 用于推导 Put 写入值的类型
+}
+
+// reg 返回 [offset, offset+size) 的显示形式，无法解析为寄存器时为十进制偏移
+func (p *irPrinter) reg(offset, size int32) string {
+	if p.layout != nil && size > 0 {
+		if v, ok := p.layout.Lookup(offset, size); ok {
+			return v.Name()
+		}
+	}
+	return strconv.Itoa(int(offset))
+}
+
+// regOfType 与 reg 相同，访问大小由类型决定
+func (p *irPrinter) regOfType(offset int32, ty IRType) string {
+	if ty == ItyINVALID {
+		return strconv.Itoa(int(offset))
+	}
+	return p.reg(offset, int32(GetIRTypeSize(ty)))
+}
+
+func (p *irPrinter) printf(format string, args ...any) {
+	fmt.Fprintf(p, format, args...)
+}
+
+// ppType 对应 ppIRType，如 I64、V128
+func ppType(t IRType) string {
+	if t == ItyINVALID {
+		return "Ity_INVALID"
+	}
+	return strings.TrimPrefix(t.String(), "Ity_")
+}
+
+// ppJumpKind 对应 ppIRJumpKind，如 Boring、Return
+func ppJumpKind(jk IRJumpKind) string {
+	if jk == IjkRet {
+		return "Return"
+	}
+	return strings.TrimPrefix(jk.String(), "Ijk_")
+}
+
+// ppEndness 返回 le 或 be
+func ppEndness(end IREndness) string {
+	if end == IendLE {
+		return "le"
+	}
+	return "be"
+}
+
+// ppEffect 对应 ppIREffect
+func ppEffect(fx IREffect) string {
+	switch fx {
+	case IfxNone:
+		return "noFX"
+	case IfxRead:
+		return "RdFX"
+	case IfxWrite:
+		return "WrFX"
+	case IfxModify:
+		return "MoFX"
+	}
+	return fx.String()
+}
+
+// ppLoadGOp 对应 ppIRLoadGOp
+func ppLoadGOp(cvt IRLoadGOp) string {
+	if cvt == ILGopInvalid {
+		return cvt.String()
+	}
+	return strings.TrimPrefix(cvt.String(), "ILGop_")
+}
+
+func (t IRTemp) String() string {
+	if t == IRTempInvalid {
+		return "IRTemp_INVALID"
+	}
+	return fmt.Sprintf("t%d", uint32(t))
+}
+
+// String 对应 ppIRConst，如 0x8:I64、F64{0x3FF0000000000000}。与 VEX 一致，十六进制使用大写
+func (c Constant) String() string {
+	switch c.Tag {
+	case IcoU1:
+		return fmt.Sprintf("%d:I1", c.Bits&1)
+	case IcoU8:
+		return fmt.Sprintf("0x%X:I8", c.Bits)
+	case IcoU16:
+		return fmt.Sprintf("0x%X:I16", c.Bits)
+	case IcoU32:
+		return fmt.Sprintf("0x%X:I32", c.Bits)
+	case IcoU64:
+		return fmt.Sprintf("0x%X:I64", c.Bits)
+	case IcoF32:
+		return fmt.Sprintf("F32{0x%X}", c.Bits)
+	case IcoF32i:
+		return fmt.Sprintf("F32i{0x%X}", c.Bits)
+	case IcoF64:
+		return fmt.Sprintf("F64{0x%X}", c.Bits)
+	case IcoF64i:
+		return fmt.Sprintf("F64i{0x%X}", c.Bits)
+	case IcoV128:
+		return fmt.Sprintf("V128{0x%04X}", c.Bits)
+	case IcoV256:
+		return fmt.Sprintf("V256{0x%08X}", c.Bits)
+	}
+	return c.Tag.String()
+}
+
+// String 对应 ppIRCallee，函数地址按 %p 以小写十六进制打印
+func (c Callee) String() string {
+	s := c.Name
+	if c.Regparms > 0 {
+		s += fmt.Sprintf("[rp=%d]", c.Regparms)
+	}
+	if c.McxMask > 0 {
+		s += fmt.Sprintf("[mcx=0x%X]", c.McxMask)
+	}
+	return s + fmt.Sprintf("{%#x}", c.Addr)
+}
+
+// String 对应 ppIRRegArray
+func (r RegArray) String() string {
+	return fmt.Sprintf("(%d:%dx%s)", r.Base, r.NElems, ppType(r.ElemTy))
+}
+
+func (p *irPrinter) args(args []Expr) {
+	for i, a := range args {
+		if i > 0 {
+			p.WriteString(",")
+		}
+		p.expr(a)
+	}
+}
+
+func (p *irPrinter) expr(e Expr) {
+	switch e := e.(type) {
+	case *BinderExpr:
+		p.printf("BIND-%d", e.Binder)
+	case *GetExpr:
+		p.printf("GET:%s(%s)", ppType(e.Ty), p.regOfType(e.Offset, e.Ty))
+	case *GetIExpr:
+		p.printf("GETI%s[", e.Descr)
+		p.expr(e.Ix)
+		p.printf(",%d]", e.Bias)
+	case *RdTmpExpr:
+		p.WriteString(e.Tmp.String())
+	case *QopExpr:
+		p.printf("%s(", e.Op)
+		p.args([]Expr{e.Arg1, e.Arg2, e.Arg3, e.Arg4})
+		p.WriteString(")")
+	case *TriopExpr:
+		p.printf("%s(", e.Op)
+		p.args([]Expr{e.Arg1, e.Arg2, e.Arg3})
+		p.WriteString(")")
+	case *BinopExpr:
+		p.printf("%s(", e.Op)
+		p.args([]Expr{e.Arg1, e.Arg2})
+		p.WriteString(")")
+	case *UnopExpr:
+		p.printf("%s(", e.Op)
+		p.expr(e.Arg)
+		p.WriteString(")")
+	case *LoadExpr:
+		p.printf("LD%s:%s(", ppEndness(e.End), ppType(e.Ty))
+		p.expr(e.Addr)
+		p.WriteString(")")
+	case *ConstExpr:
+		p.WriteString(e.Con.String())
+	case *CCallExpr:
+		p.printf("%s(", e.Cee)
+		p.args(e.Args)
+		p.printf("):%s", ppType(e.RetTy))
+	case *ITEExpr:
+		p.WriteString("ITE(")
+		p.args([]Expr{e.Cond, e.IfTrue, e.IfFalse})
+		p.WriteString(")")
+	case *VECRETExpr:
+		p.WriteString("VECRET")
+	case *GSPTRExpr:
+		p.WriteString("GSPTR")
+	case nil:
+		p.WriteString("NULL")
+	default:
+		panic("unknown IRExprTag")
+	}
+}
+
+func (p *irPrinter) stmt(s Stmt) {
+	switch s := s.(type) {
+	case *NoOpStmt:
+		p.WriteString("IR-NoOp")
+	case *IMarkStmt:
+		p.printf("------ IMark(0x%X, %d, %d) ------", s.Addr, s.Len, s.Delta)
+	case *AbiHintStmt:
+		p.WriteString("====== AbiHint(")
+		p.expr(s.Base)
+		p.printf(", %d, ", s.Len)
+		p.expr(s.Nia)
+		p.WriteString(") ======")
+	case *PutStmt:
+		p.printf("PUT(%s) = ", p.regOfType(s.Offset, typeOfExpr(p.env, s.Data)))
+		p.expr(s.Data)
+	case *PutIStmt:
+		p.printf("PUTI%s[", s.Descr)
+		p.expr(s.Ix)
+		p.printf(",%d] = ", s.Bias)
+		p.expr(s.Data)
+	case *WrTmpStmt:
+		p.printf("%s = ", s.Tmp)
+		p.expr(s.Data)
+	case *StoreStmt:
+		p.printf("ST%s(", ppEndness(s.End))
+		p.expr(s.Addr)
+		p.WriteString(") = ")
+		p.expr(s.Data)
+	case *StoreGStmt:
+		p.WriteString("if (")
+		p.expr(s.Guard)
+		p.printf(") { ST%s(", ppEndness(s.End))
+		p.expr(s.Addr)
+		p.WriteString(") = ")
+		p.expr(s.Data)
+		p.WriteString(" }")
+	case *LoadGStmt:
+		p.printf("%s = if-strict (", s.Dst)
+		p.expr(s.Guard)
+		p.printf(") %s(LD%s(", ppLoadGOp(s.Cvt), ppEndness(s.End))
+		p.expr(s.Addr)
+		p.WriteString(")) else ")
+		p.expr(s.Alt)
+	case *CASStmt:
+		if s.OldHi != IRTempInvalid {
+			p.printf("%s,", s.OldHi)
+		}
+		p.printf("%s = CAS%s(", s.OldLo, ppEndness(s.End))
+		p.expr(s.Addr)
+		p.WriteString("::")
+		if s.ExpdHi != nil {
+			p.expr(s.ExpdHi)
+			p.WriteString(",")
+		}
+		p.expr(s.ExpdLo)
+		p.WriteString("->")
+		if s.DataHi != nil {
+			p.expr(s.DataHi)
+			p.WriteString(",")
+		}
+		p.expr(s.DataLo)
+		p.WriteString(")")
+	case *LLSCStmt:
+		if s.StoreData == nil {
+			p.printf("%s = LD%s-Linked(", s.Result, ppEndness(s.End))
+			p.expr(s.Addr)
+			p.WriteString(")")
+		} else {
+			p.printf("%s = ( ST%s-Cond(", s.Result, ppEndness(s.End))
+			p.expr(s.Addr)
+			p.WriteString(") = ")
+			p.expr(s.StoreData)
+			p.WriteString(" )")
+		}
+	case *DirtyStmt:
+		if s.Tmp != IRTempInvalid {
+			p.printf("%s = ", s.Tmp)
+		}
+		p.WriteString("DIRTY ")
+		p.expr(s.Guard)
+		if s.MFx != IfxNone {
+			p.printf(" %s-mem(", ppEffect(s.MFx))
+			p.expr(s.MAddr)
+			p.printf(",%d)", s.MSize)
+		}
+		for _, fx := range s.FxState {
+			if fx.NRepeats > 0 {
+				p.printf(" %s-gst(%d,%d,reps%d,step%d", ppEffect(fx.Fx), fx.Offset, fx.Size, fx.NRepeats, fx.RepeatLen)
+			} else {
+				p.printf(" %s-gst(%s,%d", ppEffect(fx.Fx), p.reg(int32(fx.Offset), int32(fx.Size)), fx.Size)
+			}
+			p.WriteString(")")
+		}
+		p.printf(" ::: %s(", s.Cee)
+		p.args(s.Args)
+		p.WriteString(")")
+	case *MBEStmt:
+		p.printf("IR-%s", strings.TrimPrefix(s.Event.String(), "Imbe_"))
+	case *ExitStmt:
+		p.WriteString("if (")
+		p.expr(s.Guard)
+		p.printf(") { PUT(%s) = %s; exit-%s } ", p.regOfType(s.OffsIP, s.Dst.Type()), s.Dst, ppJumpKind(s.Jk))
+	case nil:
+		p.WriteString("!!! IRStmt* which is NULL !!!")
+	default:
+		panic("unknown IRStmtTag")
+	}
+}
+
+// typeEnv 对应 ppIRTypeEnv，每行 8 个临时变量
+func (p *irPrinter) typeEnv(env []IRType) {
+	for i, ty := range env {
+		if i%8 == 0 {
+			p.WriteString("   ")
+		}
+		p.printf("%s:%s", IRTemp(i), ppType(ty))
+		if i%8 == 7 {
+			p.WriteString("\n")
+		} else {
+			p.WriteString("   ")
+		}
+	}
+	if len(env) > 0 && len(env)%8 != 7 {
+		p.WriteString("\n")
+	}
+}
+
+// PrintOptions 控制 Block.Fprint 的输出
+type PrintOptions struct {
+	Arch      VexArch // 解析寄存器名使用的架构，未设置时使用 Block.Arch
+	RegNames  bool    // 以寄存器名代替 Get/Put/Exit 中的偏移，如 GET:I64(rsp)
+	StmtIndex bool    // 在每条语句前打印其下标，如 "05 | "
+}
+
+// DefaultPrintOptions 返回默认的打印参数，显示寄存器名与语句下标
+func DefaultPrintOptions() *PrintOptions {
+	return &PrintOptions{RegNames: true, StmtIndex: true}
+}
+
+// Fprint 以接近 pyvex irsb.pp() 的格式将整个块写入 w：类型环境、逐条语句以及
+// "NEXT: PUT(rip) = ...; Ijk_Boring" 形式的块出口。opts 为 nil 时使用 DefaultPrintOptions。
+// 架构未知或偏移无法解析为寄存器时仍打印数字偏移
+func (b *Block) Fprint(w io.Writer, opts *PrintOptions) error {
+	if opts == nil {
+		opts = DefaultPrintOptions()
+	}
+	p := irPrinter{env: b.TypeEnv}
+	if opts.RegNames {
+		p.layout = GetGuestLayout(opts.Arch)
+		if p.layout == nil {
+			p.layout = GetGuestLayout(b.Arch)
+		}
+	}
+	p.WriteString("IRSB {\n")
+	p.typeEnv(b.TypeEnv)
+	p.WriteString("\n")
+	for i, s := range b.Stmts {
+		p.WriteString("   ")
+		if opts.StmtIndex {
+			p.printf("%02d | ", i)
+		}
+		p.stmt(s)
+		p.WriteString("\n")
+	}
+	p.printf("   NEXT: PUT(%s) = ", p.regOfType(b.OffsIP, typeOfExpr(b.TypeEnv, b.Next)))
+	p.expr(b.Next)
+	p.printf("; %s\n}\n", b.JumpKind)
+	_, err := io.WriteString(w, p.String())
+	return err
+}
+
+// String 返回与 VEX ppIRSB 一致的文本
+func (b *Block) String() string {
+	var p irPrinter
+	p.WriteString("IRSB {\n")
+	p.typeEnv(b.TypeEnv)
+	p.WriteString("\n")
+	for _, s := range b.Stmts {
+		p.WriteString("   ")
+		p.stmt(s)
+		p.WriteString("\n")
+	}
+	p.printf("   PUT(%d) = ", b.OffsIP)
+	p.expr(b.Next)
+	p.printf("; exit-%s\n}\n", ppJumpKind(b.JumpKind))
+	return p.String()
+}
+
+func exprString(e Expr) string {
+	var p irPrinter
+	p.expr(e)
+	return p.String()
+}
+
+func stmtString(s Stmt) string {
+	var p irPrinter
+	p.stmt(s)
+	return p.String()
+}
+
+func (s *NoOpStmt) String() string    { return stmtString(s) }
+func (s *IMarkStmt) String() string   { return stmtString(s) }
+func (s *AbiHintStmt) String() string { return stmtString(s) }
+func (s *PutStmt) String() string     { return stmtString(s) }
+func (s *PutIStmt) String() string    { return stmtString(s) }
+func (s *WrTmpStmt) String() string   { return stmtString(s) }
+func (s *StoreStmt) String() string   { return stmtString(s) }
+func (s *LoadGStmt) String() string   { return stmtString(s) }
+func (s *StoreGStmt) String() string  { return stmtString(s) }
+func (s *CASStmt) String() string     { return stmtString(s) }
+func (s *LLSCStmt) String() string    { return stmtString(s) }
+func (s *DirtyStmt) String() string   { return stmtString(s) }
+func (s *MBEStmt) String() string     { return stmtString(s) }
+func (s *ExitStmt) String() string    { return stmtString(s) }
+
+func (e *BinderExpr) String() string { return exprString(e) }
+func (e *GetExpr) String() string    { return exprString(e) }
+func (e *GetIExpr) String() string   { return exprString(e) }
+func (e *RdTmpExpr) String() string  { return exprString(e) }
+func (e *QopExpr) String() string    { return exprString(e) }
+func (e *TriopExpr) String() string  { return exprString(e) }
+func (e *BinopExpr) String() string  { return exprString(e) }
+func (e *UnopExpr) String() string   { return exprString(e) }
+func (e *LoadExpr) String() string   { return exprString(e) }
+func (e *ConstExpr) String() string  { return exprString(e) }
+func (e *ITEExpr) String() string    { return exprString(e) }
+func (e *CCallExpr) String() string  { return exprString(e) }
+func (e *VECRETExpr) String() string { return exprString(e) }
+func (e *GSPTRExpr) String() string  { return exprString(e) }
+
+// Fprint 复制 VEX 内存中的块并按 Block.Fprint 打印，opts.Arch 用于解析寄存器名
+func (isb *IRSb) Fprint(w io.Writer, opts *PrintOptions) error {
+	return isb.Copy().Fprint(w, opts)
+}
+
+// String 以 ppIRSB 的格式打印 VEX 内存中的块
+func (isb *IRSb) String() string {
+	return isb.Copy().String()
+}
+
+// String 以 ppIRStmt 的格式打印 VEX 内存中的语句
+func (i *IRStmt) String() string {
+	return stmtString(i.Copy())
+}
+
+// String 以 ppIRExpr 的格式打印 VEX 内存中的表达式
+func (i *IRExpr) String() string {
+	return exprString(i.Copy())
+}
+
+// String 以 ppIRConst 的格式打印 VEX 内存中的常量
+func (c *IRConst) String() string {
+	return c.Copy().String()
+}
@@ -0,0 +1,114 @@
+package vex_go
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestPrintMatchesVex 将每条语句及块出口的打印结果与 VEX 自身的 ppIRStmt/ppIRExpr 对比
+func TestPrintMatchesVex(t *testing.T) {
+	cases := []struct {
+		name string
+		arch VexArch
+		opt  int
+		mc   []byte
+	}{
+		// lock cmpxchg [rdi], rsi; cpuid; add rax, 8; cmove rax, rcx; jz 0
+		{"amd64", VexArchAMD64, 0, []byte{0xf0, 0x48, 0x0f, 0xb1, 0x37, 0x0f, 0xa2, 0x48, 0x83, 0xc0, 0x08,
+			0x48, 0x0f, 0x44, 0xc1, 0x74, 0xef}},
+		// fld st(1); faddp st(1); call 0
+		{"x87", VexArchAMD64, 1, []byte{0xd9, 0xc1, 0xde, 0xc1, 0xe8, 0x00, 0x00, 0x00, 0x00}},
+		// ldreq r0, [r1]; streq r0, [r1]; ldrbne r2, [r1]; bx lr
+		{"arm", VexArchARM, 1, []byte{0x00, 0x00, 0x91, 0x05, 0x00, 0x00, 0x81, 0x05, 0x00, 0x20, 0xd1, 0x15,
+			0x1e, 0xff, 0x2f, 0xe1}},
+		// ldxr x0, [x1]; stxr w2, x0, [x1]; dmb ish; fmadd d0, d1, d2, d3; fadd d0, d0, d1; ret
+		{"arm64", VexArchARM64, 1, []byte{0x20, 0x7c, 0x5f, 0xc8, 0x20, 0x7c, 0x02, 0xc8, 0xbf, 0x3b, 0x03, 0xd5,
+			0x20, 0x0c, 0x42, 0x1f, 0x00, 0x28, 0x61, 0x1e, 0xc0, 0x03, 0x5f, 0xd6}},
+	}
+	for _, c := range cases {
+		opts := DefaultLiftOptions()
+		opts.OptLevel = c.opt
+		l := NewLifter(c.arch, VexEndnessLE, opts)
+		err := l.Do(c.mc, 0x400000, func(r *LiftResult) {
+			for i := 0; i < int(r.IRSB.StmtsUsed); i++ {
+				s := r.IRSB.GetStmt(i)
+				if got, want := s.String(), vexPrintStmt(s); got != want {
+					t.Errorf("%s stmt %d:\n got  %q\n want %q", c.name, i, got, want)
+				}
+			}
+			if got, want := r.IRSB.Next.String(), vexPrintExpr(r.IRSB.Next); got != want {
+				t.Errorf("%s next:\n got  %q\n want %q", c.name, got, want)
+			}
+			if got, want := r.IRSB.String(), vexPrintIRSB(r.IRSB); got != want {
+				t.Errorf("%s block:\n got\n%s\n want\n%s", c.name, got, want)
+			}
+		})
+		if err != nil {
+			t.Fatal(c.name, err)
+		}
+	}
+}
+
+func TestEnumStrings(t *testing.T) {
+	tests := []struct {
+		v    fmt.Stringer
+		want string
+	}{
+		{IstWrTmp, "Ist_WrTmp"},
+		{IexBinop, "Iex_Binop"},
+		{ItyV256, "Ity_V256"},
+		{IjkSigFPEIntDiv, "Ijk_SigFPE_IntDiv"},
+		{IcoF64i, "Ico_F64i"},
+		{VexArchAMD64, "VexArchAMD64"},
+		{VexEndnessBE, "VexEndnessBE"},
+		{IopAdd64, "Add64"},
+		{IRStmtTag(0), "IRStmtTag(0x0)"},
+		{IRTemp(3), "t3"},
+		{Constant{Tag: IcoU64, Bits: 8}, "0x8:I64"},
+		{&BinopExpr{Op: IopAdd64, Arg1: &RdTmpExpr{Tmp: 1}, Arg2: &ConstExpr{Con: Constant{Tag: IcoU64, Bits: 8}}},
+			"Add64(t1,0x8:I64)"},
+		{&WrTmpStmt{Tmp: 3, Data: &GetExpr{Offset: 16, Ty: ItyI64}}, "t3 = GET:I64(16)"},
+	}
+	for _, tt := range tests {
+		if got := tt.v.String(); got != tt.want {
+			t.Errorf("got %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestBlockFprint(t *testing.T) {
+	l := NewLifter(VexArchAMD64, VexEndnessLE, nil)
+	// mov rax, [rdi+8]; add rax, rsi; test rax, rax; jz 0; ret
+	mc := []byte{0x48, 0x8b, 0x47, 0x08, 0x48, 0x01, 0xf0, 0x48, 0x85, 0xc0, 0x74, 0xf4, 0xc3}
+	r, err := l.Lift(mc, 0x400000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sb strings.Builder
+	if err := r.Block.Fprint(&sb, nil); err != nil {
+		t.Fatal(err)
+	}
+	out := sb.String()
+	for _, want := range []string{
+		"IRSB {\n   t0:",
+		"   00 | ------ IMark(0x400000, 4, 0) ------\n",
+		"GET:I64(rdi)",
+		"PUT(rax) = ",
+		"PUT(cc_op) = ",
+		"{ PUT(rip) = 0x400000:I64; exit-Boring }",
+		"   NEXT: PUT(rip) = 0x40000C:I64; Ijk_Boring\n}\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q", want)
+		}
+	}
+
+	sb.Reset()
+	if err := r.Block.Fprint(&sb, &PrintOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if out := sb.String(); strings.Contains(out, "rax") || !strings.Contains(out, "NEXT: PUT(184) = ") {
+		t.Errorf("offsets expected without RegNames:\n%s", out)
+	}
+}
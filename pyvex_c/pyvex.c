@@ -221,8 +221,11 @@ int vex_init() {
     return 1;
 }
 
-// Prepare the VexArchInfo struct
-static void vex_prepare_vai(VexArch arch, VexArchInfo *vai) {
+// Fill in the default VexArchInfo for a guest arch. vex_lift uses the
+// archinfo it is given as-is, so callers start from these defaults and
+// adjust hwcaps etc. for a specific CPU model.
+void vex_default_archinfo(VexArch arch, VexArchInfo *vai) {
+	LibVEX_default_VexArchInfo(vai);
 	switch (arch) {
 		case VexArchX86:
 			vai->hwcaps =   VEX_HWCAPS_X86_MMXEXT |
@@ -280,7 +283,7 @@ static void vex_prepare_vai(VexArch arch, VexArchInfo *vai) {
 			vai->hwcaps = 0;
 			break;
 		default:
-			pyvex_error("Invalid arch in vex_prepare_vai.\n");
+			pyvex_error("Invalid arch in vex_default_archinfo.\n");
 			break;
 	}
 }
@@ -328,7 +331,6 @@ VEXLiftResult *vex_lift(
 		unsigned int lookback) {
 	VexRegisterUpdates pxControl = px_control;
 
-	vex_prepare_vai(guest, &archinfo);
 	vex_prepare_vbi(guest, &vbi);
 
 	pyvex_debug("Guest arch: %d\n", guest);
@@ -71,6 +71,8 @@ typedef struct _VEXLiftResult {
 	ConstVal const_vals[MAX_CONST_VALS];
 } VEXLiftResult;
 
+void vex_default_archinfo(VexArch arch, VexArchInfo *vai);
+
 VEXLiftResult *vex_lift(
 		VexArch guest,
 		VexArchInfo archinfo,
@@ -0,0 +1,256 @@
+package vex_go
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Register 描述客户机状态中的一个寄存器，子寄存器的 Parent 为其所属的完整寄存器
+type Register struct {
+	Name   string // 小写名称
+	Offset int32  // 在客户机状态中的偏移
+	Size   int32  // 字节数
+	Parent string // 完整寄存器为空
+	Pseudo bool   // VEX 内部使用的伪寄存器，如 CC_OP、EMNOTE、IP_AT_SYSCALL
+}
+
+// regTable 是一个架构的寄存器表
+type regTable struct {
+	regs    []Register
+	aliases map[string]string // 别名 -> 规范名称
+	byName  map[string]int
+}
+
+func newRegTable(regs []Register, aliases map[string]string) *regTable {
+	t := &regTable{regs: regs, aliases: aliases, byName: make(map[string]int, len(regs))}
+	for i, r := range regs {
+		t.byName[r.Name] = i
+	}
+	return t
+}
+
+var registerTables = map[VexArch]*regTable{
+	VexArchX86:     newRegTable(x86Registers, x86RegisterAliases),
+	VexArchAMD64:   newRegTable(amd64Registers, amd64RegisterAliases),
+	VexArchARM:     newRegTable(armRegisters, armRegisterAliases),
+	VexArchARM64:   arm64RegTable(),
+	VexArchPPC32:   newRegTable(ppc32Registers, ppc32RegisterAliases),
+	VexArchPPC64:   newRegTable(ppc64Registers, ppc64RegisterAliases),
+	VexArchS390X:   newRegTable(s390xRegisters, s390xRegisterAliases),
+	VexArchMIPS32:  newRegTable(mips32Registers, mips32RegisterAliases),
+	VexArchMIPS64:  newRegTable(mips64Registers, mips64RegisterAliases),
+	VexArchTILEGX:  newRegTable(tilegxRegisters, tilegxRegisterAliases),
+	VexArchRISCV64: newRegTable(riscv64Registers, riscv64RegisterAliases),
+}
+
+// arm64RegisterAliases 中的名称在 ARM64RegisterOffsets 中与规范名称共用偏移
+var arm64RegisterAliases = map[string]string{
+	"fp":  "x29",
+	"lr":  "x30",
+	"xsp": "sp",
+	"xpc": "pc",
+}
+
+// arm64PseudoRegisters 是 ARM64RegisterOffsets 中除 cc_ 前缀外的伪寄存器
+var arm64PseudoRegisters = map[string]bool{
+	"emnote":        true,
+	"cmstart":       true,
+	"cmlen":         true,
+	"nraddr":        true,
+	"ip_at_syscall": true,
+}
+
+// arm64RegTable 由 ARM64RegisterOffsets 生成 ARM64 的寄存器表
+func arm64RegTable() *regTable {
+	var regs []Register
+	for name, off := range ARM64RegisterOffsets {
+		if name != strings.ToLower(name) {
+			continue
+		}
+		if _, ok := arm64RegisterAliases[name]; ok {
+			continue
+		}
+		size, parent := arm64RegShape(name)
+		pseudo := strings.HasPrefix(name, "cc_") || arm64PseudoRegisters[name]
+		regs = append(regs, Register{Name: name, Offset: int32(off), Size: size, Parent: parent, Pseudo: pseudo})
+	}
+	sort.Slice(regs, func(i, j int) bool {
+		a, b := regs[i], regs[j]
+		if a.Offset != b.Offset {
+			return a.Offset < b.Offset
+		}
+		if a.Size != b.Size {
+			return a.Size > b.Size
+		}
+		return a.Name < b.Name
+	})
+	return newRegTable(regs, arm64RegisterAliases)
+}
+
+// arm64RegShape 根据名称推断 ARM64 寄存器的大小和所属的完整寄存器
+func arm64RegShape(name string) (int32, string) {
+	switch name {
+	case "wsp":
+		return 4, "sp"
+	case "qc_flag":
+		return 16, ""
+	case "fpcr", "emnote":
+		return 4, ""
+	}
+	n := name[1:]
+	if n == "" || n[0] < '0' || n[0] > '9' {
+		return 8, ""
+	}
+	switch name[0] {
+	case 'w':
+		return 4, "x" + n
+	case 'q':
+		return 16, ""
+	case 'd':
+		return 8, "q" + n
+	case 's':
+		return 4, "q" + n
+	case 'h':
+		return 2, "q" + n
+	case 'b':
+		return 1, "q" + n
+	}
+	return 8, ""
+}
+
+// Registers 返回架构的全部寄存器（含子寄存器），按偏移排序，完整寄存器在其子寄存器之前
+func Registers(arch VexArch) []Register {
+	t, ok := registerTables[arch]
+	if !ok {
+		return nil
+	}
+	return append([]Register(nil), t.regs...)
+}
+
+// LookupRegister 根据名称查找寄存器，名称不区分大小写，支持 ABI 别名（如 sp、lr、a0）
+func LookupRegister(arch VexArch, name string) (Register, bool) {
+	t, ok := registerTables[arch]
+	if !ok {
+		return Register{}, false
+	}
+	name = strings.ToLower(name)
+	if canon, ok := t.aliases[name]; ok {
+		name = canon
+	}
+	i, ok := t.byName[name]
+	if !ok {
+		return Register{}, false
+	}
+	return t.regs[i], true
+}
+
+// RegisterOffset 根据寄存器名称返回其在客户机状态中的偏移
+func RegisterOffset(arch VexArch, name string) (int32, bool) {
+	r, ok := LookupRegister(arch, name)
+	return r.Offset, ok
+}
+
+// RegisterName 返回恰好位于 offset 且大小为 size 字节的寄存器名称，
+// 例如 AMD64 的 (16, 4) 为 eax，(17, 1) 为 ah
+func RegisterName(arch VexArch, offset, size int32) (string, bool) {
+	t, ok := registerTables[arch]
+	if !ok {
+		return "", false
+	}
+	for _, r := range t.regs {
+		if r.Offset == offset && r.Size == size {
+			return r.Name, true
+		}
+	}
+	return "", false
+}
+
+// GuestLayout 描述一个架构的客户机状态布局，用于把 Get/Put 的偏移还原为寄存器
+type GuestLayout struct {
+	Arch      VexArch
+	StateSize int32 // 客户机状态结构体的字节数，未知时为 0
+	t         *regTable
+	full      []Register // 完整寄存器，按偏移排序
+}
+
+// RegView 是对客户机状态中一段 (offset, size) 访问的解析结果
+type RegView struct {
+	Register Register // 覆盖该访问的完整寄存器
+	Sub      Register // 与访问恰好重合的寄存器视图，可能就是 Register 本身；没有时 Name 为空
+	Delta    int32    // 访问相对于 Register 起始处的偏移
+}
+
+// Pseudo 表示访问的是否为伪寄存器
+func (v RegView) Pseudo() bool {
+	return v.Register.Pseudo
+}
+
+// Name 返回访问的可读名称：恰好重合时为子寄存器名，否则为 "rax+1" 形式
+func (v RegView) Name() string {
+	if v.Sub.Name != "" {
+		return v.Sub.Name
+	}
+	return fmt.Sprintf("%s+%d", v.Register.Name, v.Delta)
+}
+
+var guestLayouts = newGuestLayouts()
+
+func newGuestLayouts() map[VexArch]*GuestLayout {
+	layouts := map[VexArch]*GuestLayout{}
+	for arch, t := range registerTables {
+		l := &GuestLayout{Arch: arch, StateSize: guestStateSizes[arch], t: t}
+		for _, r := range t.regs {
+			if r.Parent == "" {
+				l.full = append(l.full, r)
+			}
+		}
+		sort.SliceStable(l.full, func(i, j int) bool {
+			return l.full[i].Offset < l.full[j].Offset
+		})
+		layouts[arch] = l
+	}
+	return layouts
+}
+
+// GetGuestLayout 返回架构的客户机状态布局，未知架构返回 nil
+func GetGuestLayout(arch VexArch) *GuestLayout {
+	return guestLayouts[arch]
+}
+
+// Lookup 返回覆盖 [offset, offset+size) 的寄存器，跨越多个寄存器或落在填充区时返回 false
+func (l *GuestLayout) Lookup(offset, size int32) (RegView, bool) {
+	i := sort.Search(len(l.full), func(i int) bool {
+		return l.full[i].Offset > offset
+	}) - 1
+	if i < 0 {
+		return RegView{}, false
+	}
+	r := l.full[i]
+	if offset+size > r.Offset+r.Size {
+		return RegView{}, false
+	}
+	v := RegView{Register: r, Delta: offset - r.Offset}
+	if r.Offset == offset && r.Size == size {
+		v.Sub = r
+		return v, true
+	}
+	for _, sub := range l.t.regs {
+		if sub.Parent == r.Name && sub.Offset == offset && sub.Size == size {
+			v.Sub = sub
+			break
+		}
+	}
+	return v, true
+}
+
+// LookupType 以 IR 类型的大小解析访问，用于 Get.Offset/Ty 与 Put.Offset 加写入值的类型
+func (l *GuestLayout) LookupType(offset int32, ty IRType) (RegView, bool) {
+	return l.Lookup(offset, int32(GetIRTypeSize(ty)))
+}
+
+// IsPseudo 判断偏移是否位于伪寄存器中
+func (l *GuestLayout) IsPseudo(offset int32) bool {
+	v, ok := l.Lookup(offset, 1)
+	return ok && v.Pseudo()
+}
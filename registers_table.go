@@ -0,0 +1,1356 @@
+package vex_go
+
+// 本文件中的偏移取自 vex/pub/libvex_guest_*.h 中各 VexGuest*State 结构体的布局，
+// 子寄存器的偏移按该架构的默认字节序计算
+
+// x86Registers 是 VexGuestX86State 中的寄存器
+var x86Registers = []Register{
+	{"eax", 8, 4, "", false},            // 0x8
+	{"ax", 8, 2, "eax", false},          // 0x8
+	{"al", 8, 1, "eax", false},          // 0x8
+	{"ah", 9, 1, "eax", false},          // 0x9
+	{"ecx", 12, 4, "", false},           // 0xc
+	{"cx", 12, 2, "ecx", false},         // 0xc
+	{"cl", 12, 1, "ecx", false},         // 0xc
+	{"ch", 13, 1, "ecx", false},         // 0xd
+	{"edx", 16, 4, "", false},           // 0x10
+	{"dx", 16, 2, "edx", false},         // 0x10
+	{"dl", 16, 1, "edx", false},         // 0x10
+	{"dh", 17, 1, "edx", false},         // 0x11
+	{"ebx", 20, 4, "", false},           // 0x14
+	{"bx", 20, 2, "ebx", false},         // 0x14
+	{"bl", 20, 1, "ebx", false},         // 0x14
+	{"bh", 21, 1, "ebx", false},         // 0x15
+	{"esp", 24, 4, "", false},           // 0x18
+	{"sp", 24, 2, "esp", false},         // 0x18
+	{"ebp", 28, 4, "", false},           // 0x1c
+	{"bp", 28, 2, "ebp", false},         // 0x1c
+	{"esi", 32, 4, "", false},           // 0x20
+	{"si", 32, 2, "esi", false},         // 0x20
+	{"edi", 36, 4, "", false},           // 0x24
+	{"di", 36, 2, "edi", false},         // 0x24
+	{"cc_op", 40, 4, "", true},          // 0x28
+	{"cc_dep1", 44, 4, "", true},        // 0x2c
+	{"cc_dep2", 48, 4, "", true},        // 0x30
+	{"cc_ndep", 52, 4, "", true},        // 0x34
+	{"dflag", 56, 4, "", false},         // 0x38
+	{"idflag", 60, 4, "", false},        // 0x3c
+	{"acflag", 64, 4, "", false},        // 0x40
+	{"eip", 68, 4, "", false},           // 0x44
+	{"ip", 68, 2, "eip", false},         // 0x44
+	{"fpreg", 72, 64, "", false},        // 0x48
+	{"fptag", 136, 8, "", false},        // 0x88
+	{"fpround", 144, 4, "", false},      // 0x90
+	{"fc3210", 148, 4, "", false},       // 0x94
+	{"ftop", 152, 4, "", false},         // 0x98
+	{"sseround", 156, 4, "", false},     // 0x9c
+	{"xmm0", 160, 16, "", false},        // 0xa0
+	{"xmm1", 176, 16, "", false},        // 0xb0
+	{"xmm2", 192, 16, "", false},        // 0xc0
+	{"xmm3", 208, 16, "", false},        // 0xd0
+	{"xmm4", 224, 16, "", false},        // 0xe0
+	{"xmm5", 240, 16, "", false},        // 0xf0
+	{"xmm6", 256, 16, "", false},        // 0x100
+	{"xmm7", 272, 16, "", false},        // 0x110
+	{"cs", 288, 2, "", false},           // 0x120
+	{"ds", 290, 2, "", false},           // 0x122
+	{"es", 292, 2, "", false},           // 0x124
+	{"fs", 294, 2, "", false},           // 0x126
+	{"gs", 296, 2, "", false},           // 0x128
+	{"ss", 298, 2, "", false},           // 0x12a
+	{"ldt", 304, 8, "", false},          // 0x130
+	{"gdt", 312, 8, "", false},          // 0x138
+	{"emnote", 320, 4, "", true},        // 0x140
+	{"cmstart", 324, 4, "", true},       // 0x144
+	{"cmlen", 328, 4, "", true},         // 0x148
+	{"nraddr", 332, 4, "", true},        // 0x14c
+	{"sc_class", 336, 4, "", true},      // 0x150
+	{"ip_at_syscall", 340, 4, "", true}, // 0x154
+}
+
+var x86RegisterAliases = map[string]string{
+	"pc": "eip",
+}
+
+// amd64Registers 是 VexGuestAMD64State 中的寄存器
+var amd64Registers = []Register{
+	{"rax", 16, 8, "", false},            // 0x10
+	{"eax", 16, 4, "rax", false},         // 0x10
+	{"ax", 16, 2, "rax", false},          // 0x10
+	{"al", 16, 1, "rax", false},          // 0x10
+	{"ah", 17, 1, "rax", false},          // 0x11
+	{"rcx", 24, 8, "", false},            // 0x18
+	{"ecx", 24, 4, "rcx", false},         // 0x18
+	{"cx", 24, 2, "rcx", false},          // 0x18
+	{"cl", 24, 1, "rcx", false},          // 0x18
+	{"ch", 25, 1, "rcx", false},          // 0x19
+	{"rdx", 32, 8, "", false},            // 0x20
+	{"edx", 32, 4, "rdx", false},         // 0x20
+	{"dx", 32, 2, "rdx", false},          // 0x20
+	{"dl", 32, 1, "rdx", false},          // 0x20
+	{"dh", 33, 1, "rdx", false},          // 0x21
+	{"rbx", 40, 8, "", false},            // 0x28
+	{"ebx", 40, 4, "rbx", false},         // 0x28
+	{"bx", 40, 2, "rbx", false},          // 0x28
+	{"bl", 40, 1, "rbx", false},          // 0x28
+	{"bh", 41, 1, "rbx", false},          // 0x29
+	{"rsp", 48, 8, "", false},            // 0x30
+	{"esp", 48, 4, "rsp", false},         // 0x30
+	{"sp", 48, 2, "rsp", false},          // 0x30
+	{"spl", 48, 1, "rsp", false},         // 0x30
+	{"rbp", 56, 8, "", false},            // 0x38
+	{"ebp", 56, 4, "rbp", false},         // 0x38
+	{"bp", 56, 2, "rbp", false},          // 0x38
+	{"bpl", 56, 1, "rbp", false},         // 0x38
+	{"rsi", 64, 8, "", false},            // 0x40
+	{"esi", 64, 4, "rsi", false},         // 0x40
+	{"si", 64, 2, "rsi", false},          // 0x40
+	{"sil", 64, 1, "rsi", false},         // 0x40
+	{"rdi", 72, 8, "", false},            // 0x48
+	{"edi", 72, 4, "rdi", false},         // 0x48
+	{"di", 72, 2, "rdi", false},          // 0x48
+	{"dil", 72, 1, "rdi", false},         // 0x48
+	{"r8", 80, 8, "", false},             // 0x50
+	{"r8d", 80, 4, "r8", false},          // 0x50
+	{"r8w", 80, 2, "r8", false},          // 0x50
+	{"r8b", 80, 1, "r8", false},          // 0x50
+	{"r9", 88, 8, "", false},             // 0x58
+	{"r9d", 88, 4, "r9", false},          // 0x58
+	{"r9w", 88, 2, "r9", false},          // 0x58
+	{"r9b", 88, 1, "r9", false},          // 0x58
+	{"r10", 96, 8, "", false},            // 0x60
+	{"r10d", 96, 4, "r10", false},        // 0x60
+	{"r10w", 96, 2, "r10", false},        // 0x60
+	{"r10b", 96, 1, "r10", false},        // 0x60
+	{"r11", 104, 8, "", false},           // 0x68
+	{"r11d", 104, 4, "r11", false},       // 0x68
+	{"r11w", 104, 2, "r11", false},       // 0x68
+	{"r11b", 104, 1, "r11", false},       // 0x68
+	{"r12", 112, 8, "", false},           // 0x70
+	{"r12d", 112, 4, "r12", false},       // 0x70
+	{"r12w", 112, 2, "r12", false},       // 0x70
+	{"r12b", 112, 1, "r12", false},       // 0x70
+	{"r13", 120, 8, "", false},           // 0x78
+	{"r13d", 120, 4, "r13", false},       // 0x78
+	{"r13w", 120, 2, "r13", false},       // 0x78
+	{"r13b", 120, 1, "r13", false},       // 0x78
+	{"r14", 128, 8, "", false},           // 0x80
+	{"r14d", 128, 4, "r14", false},       // 0x80
+	{"r14w", 128, 2, "r14", false},       // 0x80
+	{"r14b", 128, 1, "r14", false},       // 0x80
+	{"r15", 136, 8, "", false},           // 0x88
+	{"r15d", 136, 4, "r15", false},       // 0x88
+	{"r15w", 136, 2, "r15", false},       // 0x88
+	{"r15b", 136, 1, "r15", false},       // 0x88
+	{"cc_op", 144, 8, "", true},          // 0x90
+	{"cc_dep1", 152, 8, "", true},        // 0x98
+	{"cc_dep2", 160, 8, "", true},        // 0xa0
+	{"cc_ndep", 168, 8, "", true},        // 0xa8
+	{"dflag", 176, 8, "", false},         // 0xb0
+	{"rip", 184, 8, "", false},           // 0xb8
+	{"eip", 184, 4, "rip", false},        // 0xb8
+	{"acflag", 192, 8, "", false},        // 0xc0
+	{"idflag", 200, 8, "", false},        // 0xc8
+	{"fs_const", 208, 8, "", false},      // 0xd0
+	{"sseround", 216, 8, "", false},      // 0xd8
+	{"ymm0", 224, 32, "", false},         // 0xe0
+	{"xmm0", 224, 16, "ymm0", false},     // 0xe0
+	{"ymm1", 256, 32, "", false},         // 0x100
+	{"xmm1", 256, 16, "ymm1", false},     // 0x100
+	{"ymm2", 288, 32, "", false},         // 0x120
+	{"xmm2", 288, 16, "ymm2", false},     // 0x120
+	{"ymm3", 320, 32, "", false},         // 0x140
+	{"xmm3", 320, 16, "ymm3", false},     // 0x140
+	{"ymm4", 352, 32, "", false},         // 0x160
+	{"xmm4", 352, 16, "ymm4", false},     // 0x160
+	{"ymm5", 384, 32, "", false},         // 0x180
+	{"xmm5", 384, 16, "ymm5", false},     // 0x180
+	{"ymm6", 416, 32, "", false},         // 0x1a0
+	{"xmm6", 416, 16, "ymm6", false},     // 0x1a0
+	{"ymm7", 448, 32, "", false},         // 0x1c0
+	{"xmm7", 448, 16, "ymm7", false},     // 0x1c0
+	{"ymm8", 480, 32, "", false},         // 0x1e0
+	{"xmm8", 480, 16, "ymm8", false},     // 0x1e0
+	{"ymm9", 512, 32, "", false},         // 0x200
+	{"xmm9", 512, 16, "ymm9", false},     // 0x200
+	{"ymm10", 544, 32, "", false},        // 0x220
+	{"xmm10", 544, 16, "ymm10", false},   // 0x220
+	{"ymm11", 576, 32, "", false},        // 0x240
+	{"xmm11", 576, 16, "ymm11", false},   // 0x240
+	{"ymm12", 608, 32, "", false},        // 0x260
+	{"xmm12", 608, 16, "ymm12", false},   // 0x260
+	{"ymm13", 640, 32, "", false},        // 0x280
+	{"xmm13", 640, 16, "ymm13", false},   // 0x280
+	{"ymm14", 672, 32, "", false},        // 0x2a0
+	{"xmm14", 672, 16, "ymm14", false},   // 0x2a0
+	{"ymm15", 704, 32, "", false},        // 0x2c0
+	{"xmm15", 704, 16, "ymm15", false},   // 0x2c0
+	{"ymm16", 736, 32, "", true},         // 0x2e0
+	{"cr0", 768, 8, "", false},           // 0x300
+	{"cr1", 776, 8, "", false},           // 0x308
+	{"cr2", 784, 8, "", false},           // 0x310
+	{"cr3", 792, 8, "", false},           // 0x318
+	{"cr4", 800, 8, "", false},           // 0x320
+	{"cr5", 808, 8, "", false},           // 0x328
+	{"cr6", 816, 8, "", false},           // 0x330
+	{"cr7", 824, 8, "", false},           // 0x338
+	{"cr8", 832, 8, "", false},           // 0x340
+	{"cr9", 840, 8, "", false},           // 0x348
+	{"cr10", 848, 8, "", false},          // 0x350
+	{"cr11", 856, 8, "", false},          // 0x358
+	{"cr12", 864, 8, "", false},          // 0x360
+	{"cr13", 872, 8, "", false},          // 0x368
+	{"cr14", 880, 8, "", false},          // 0x370
+	{"cr15", 888, 8, "", false},          // 0x378
+	{"ftop", 896, 4, "", false},          // 0x380
+	{"fpreg", 904, 64, "", false},        // 0x388
+	{"fptag", 968, 8, "", false},         // 0x3c8
+	{"fpround", 976, 8, "", false},       // 0x3d0
+	{"fc3210", 984, 8, "", false},        // 0x3d8
+	{"emnote", 992, 4, "", true},         // 0x3e0
+	{"cmstart", 1000, 8, "", true},       // 0x3e8
+	{"cmlen", 1008, 8, "", true},         // 0x3f0
+	{"nraddr", 1016, 8, "", true},        // 0x3f8
+	{"sc_class", 1024, 8, "", true},      // 0x400
+	{"gs_const", 1032, 8, "", false},     // 0x408
+	{"ip_at_syscall", 1040, 8, "", true}, // 0x410
+	{"cs", 1048, 2, "", false},           // 0x418
+	{"ds", 1050, 2, "", false},           // 0x41a
+	{"es", 1052, 2, "", false},           // 0x41c
+	{"fs", 1054, 2, "", false},           // 0x41e
+	{"gs", 1056, 2, "", false},           // 0x420
+	{"ss", 1058, 2, "", false},           // 0x422
+}
+
+var amd64RegisterAliases = map[string]string{
+	"pc": "rip",
+}
+
+// armRegisters 是 VexGuestARMState 中的寄存器
+var armRegisters = []Register{
+	{"r0", 8, 4, "", false},             // 0x8
+	{"r1", 12, 4, "", false},            // 0xc
+	{"r2", 16, 4, "", false},            // 0x10
+	{"r3", 20, 4, "", false},            // 0x14
+	{"r4", 24, 4, "", false},            // 0x18
+	{"r5", 28, 4, "", false},            // 0x1c
+	{"r6", 32, 4, "", false},            // 0x20
+	{"r7", 36, 4, "", false},            // 0x24
+	{"r8", 40, 4, "", false},            // 0x28
+	{"r9", 44, 4, "", false},            // 0x2c
+	{"r10", 48, 4, "", false},           // 0x30
+	{"r11", 52, 4, "", false},           // 0x34
+	{"r12", 56, 4, "", false},           // 0x38
+	{"r13", 60, 4, "", false},           // 0x3c
+	{"r14", 64, 4, "", false},           // 0x40
+	{"r15", 68, 4, "", false},           // 0x44
+	{"cc_op", 72, 4, "", true},          // 0x48
+	{"cc_dep1", 76, 4, "", true},        // 0x4c
+	{"cc_dep2", 80, 4, "", true},        // 0x50
+	{"cc_ndep", 84, 4, "", true},        // 0x54
+	{"qflag32", 88, 4, "", false},       // 0x58
+	{"geflag0", 92, 4, "", false},       // 0x5c
+	{"geflag1", 96, 4, "", false},       // 0x60
+	{"geflag2", 100, 4, "", false},      // 0x64
+	{"geflag3", 104, 4, "", false},      // 0x68
+	{"emnote", 108, 4, "", true},        // 0x6c
+	{"cmstart", 112, 4, "", true},       // 0x70
+	{"cmlen", 116, 4, "", true},         // 0x74
+	{"nraddr", 120, 4, "", true},        // 0x78
+	{"ip_at_syscall", 124, 4, "", true}, // 0x7c
+	{"q0", 128, 16, "", false},          // 0x80
+	{"d0", 128, 8, "q0", false},         // 0x80
+	{"d1", 136, 8, "q0", false},         // 0x88
+	{"s0", 128, 4, "q0", false},         // 0x80
+	{"s1", 132, 4, "q0", false},         // 0x84
+	{"s2", 136, 4, "q0", false},         // 0x88
+	{"s3", 140, 4, "q0", false},         // 0x8c
+	{"q1", 144, 16, "", false},          // 0x90
+	{"d2", 144, 8, "q1", false},         // 0x90
+	{"d3", 152, 8, "q1", false},         // 0x98
+	{"s4", 144, 4, "q1", false},         // 0x90
+	{"s5", 148, 4, "q1", false},         // 0x94
+	{"s6", 152, 4, "q1", false},         // 0x98
+	{"s7", 156, 4, "q1", false},         // 0x9c
+	{"q2", 160, 16, "", false},          // 0xa0
+	{"d4", 160, 8, "q2", false},         // 0xa0
+	{"d5", 168, 8, "q2", false},         // 0xa8
+	{"s8", 160, 4, "q2", false},         // 0xa0
+	{"s9", 164, 4, "q2", false},         // 0xa4
+	{"s10", 168, 4, "q2", false},        // 0xa8
+	{"s11", 172, 4, "q2", false},        // 0xac
+	{"q3", 176, 16, "", false},          // 0xb0
+	{"d6", 176, 8, "q3", false},         // 0xb0
+	{"d7", 184, 8, "q3", false},         // 0xb8
+	{"s12", 176, 4, "q3", false},        // 0xb0
+	{"s13", 180, 4, "q3", false},        // 0xb4
+	{"s14", 184, 4, "q3", false},        // 0xb8
+	{"s15", 188, 4, "q3", false},        // 0xbc
+	{"q4", 192, 16, "", false},          // 0xc0
+	{"d8", 192, 8, "q4", false},         // 0xc0
+	{"d9", 200, 8, "q4", false},         // 0xc8
+	{"s16", 192, 4, "q4", false},        // 0xc0
+	{"s17", 196, 4, "q4", false},        // 0xc4
+	{"s18", 200, 4, "q4", false},        // 0xc8
+	{"s19", 204, 4, "q4", false},        // 0xcc
+	{"q5", 208, 16, "", false},          // 0xd0
+	{"d10", 208, 8, "q5", false},        // 0xd0
+	{"d11", 216, 8, "q5", false},        // 0xd8
+	{"s20", 208, 4, "q5", false},        // 0xd0
+	{"s21", 212, 4, "q5", false},        // 0xd4
+	{"s22", 216, 4, "q5", false},        // 0xd8
+	{"s23", 220, 4, "q5", false},        // 0xdc
+	{"q6", 224, 16, "", false},          // 0xe0
+	{"d12", 224, 8, "q6", false},        // 0xe0
+	{"d13", 232, 8, "q6", false},        // 0xe8
+	{"s24", 224, 4, "q6", false},        // 0xe0
+	{"s25", 228, 4, "q6", false},        // 0xe4
+	{"s26", 232, 4, "q6", false},        // 0xe8
+	{"s27", 236, 4, "q6", false},        // 0xec
+	{"q7", 240, 16, "", false},          // 0xf0
+	{"d14", 240, 8, "q7", false},        // 0xf0
+	{"d15", 248, 8, "q7", false},        // 0xf8
+	{"s28", 240, 4, "q7", false},        // 0xf0
+	{"s29", 244, 4, "q7", false},        // 0xf4
+	{"s30", 248, 4, "q7", false},        // 0xf8
+	{"s31", 252, 4, "q7", false},        // 0xfc
+	{"q8", 256, 16, "", false},          // 0x100
+	{"d16", 256, 8, "q8", false},        // 0x100
+	{"d17", 264, 8, "q8", false},        // 0x108
+	{"q9", 272, 16, "", false},          // 0x110
+	{"d18", 272, 8, "q9", false},        // 0x110
+	{"d19", 280, 8, "q9", false},        // 0x118
+	{"q10", 288, 16, "", false},         // 0x120
+	{"d20", 288, 8, "q10", false},       // 0x120
+	{"d21", 296, 8, "q10", false},       // 0x128
+	{"q11", 304, 16, "", false},         // 0x130
+	{"d22", 304, 8, "q11", false},       // 0x130
+	{"d23", 312, 8, "q11", false},       // 0x138
+	{"q12", 320, 16, "", false},         // 0x140
+	{"d24", 320, 8, "q12", false},       // 0x140
+	{"d25", 328, 8, "q12", false},       // 0x148
+	{"q13", 336, 16, "", false},         // 0x150
+	{"d26", 336, 8, "q13", false},       // 0x150
+	{"d27", 344, 8, "q13", false},       // 0x158
+	{"q14", 352, 16, "", false},         // 0x160
+	{"d28", 352, 8, "q14", false},       // 0x160
+	{"d29", 360, 8, "q14", false},       // 0x168
+	{"q15", 368, 16, "", false},         // 0x170
+	{"d30", 368, 8, "q15", false},       // 0x170
+	{"d31", 376, 8, "q15", false},       // 0x178
+	{"fpscr", 384, 4, "", false},        // 0x180
+	{"tpidruro", 388, 4, "", false},     // 0x184
+	{"itstate", 392, 4, "", true},       // 0x188
+}
+
+var armRegisterAliases = map[string]string{
+	"sb": "r9",
+	"sl": "r10",
+	"fp": "r11",
+	"ip": "r12",
+	"sp": "r13",
+	"lr": "r14",
+	"pc": "r15",
+}
+
+// ppc32Registers 是 VexGuestPPC32State 中的寄存器
+var ppc32Registers = []Register{
+	{"r0", 16, 4, "", false},             // 0x10
+	{"r1", 20, 4, "", false},             // 0x14
+	{"r2", 24, 4, "", false},             // 0x18
+	{"r3", 28, 4, "", false},             // 0x1c
+	{"r4", 32, 4, "", false},             // 0x20
+	{"r5", 36, 4, "", false},             // 0x24
+	{"r6", 40, 4, "", false},             // 0x28
+	{"r7", 44, 4, "", false},             // 0x2c
+	{"r8", 48, 4, "", false},             // 0x30
+	{"r9", 52, 4, "", false},             // 0x34
+	{"r10", 56, 4, "", false},            // 0x38
+	{"r11", 60, 4, "", false},            // 0x3c
+	{"r12", 64, 4, "", false},            // 0x40
+	{"r13", 68, 4, "", false},            // 0x44
+	{"r14", 72, 4, "", false},            // 0x48
+	{"r15", 76, 4, "", false},            // 0x4c
+	{"r16", 80, 4, "", false},            // 0x50
+	{"r17", 84, 4, "", false},            // 0x54
+	{"r18", 88, 4, "", false},            // 0x58
+	{"r19", 92, 4, "", false},            // 0x5c
+	{"r20", 96, 4, "", false},            // 0x60
+	{"r21", 100, 4, "", false},           // 0x64
+	{"r22", 104, 4, "", false},           // 0x68
+	{"r23", 108, 4, "", false},           // 0x6c
+	{"r24", 112, 4, "", false},           // 0x70
+	{"r25", 116, 4, "", false},           // 0x74
+	{"r26", 120, 4, "", false},           // 0x78
+	{"r27", 124, 4, "", false},           // 0x7c
+	{"r28", 128, 4, "", false},           // 0x80
+	{"r29", 132, 4, "", false},           // 0x84
+	{"r30", 136, 4, "", false},           // 0x88
+	{"r31", 140, 4, "", false},           // 0x8c
+	{"vsr0", 144, 16, "", false},         // 0x90
+	{"f0", 144, 8, "vsr0", false},        // 0x90
+	{"vsr1", 160, 16, "", false},         // 0xa0
+	{"f1", 160, 8, "vsr1", false},        // 0xa0
+	{"vsr2", 176, 16, "", false},         // 0xb0
+	{"f2", 176, 8, "vsr2", false},        // 0xb0
+	{"vsr3", 192, 16, "", false},         // 0xc0
+	{"f3", 192, 8, "vsr3", false},        // 0xc0
+	{"vsr4", 208, 16, "", false},         // 0xd0
+	{"f4", 208, 8, "vsr4", false},        // 0xd0
+	{"vsr5", 224, 16, "", false},         // 0xe0
+	{"f5", 224, 8, "vsr5", false},        // 0xe0
+	{"vsr6", 240, 16, "", false},         // 0xf0
+	{"f6", 240, 8, "vsr6", false},        // 0xf0
+	{"vsr7", 256, 16, "", false},         // 0x100
+	{"f7", 256, 8, "vsr7", false},        // 0x100
+	{"vsr8", 272, 16, "", false},         // 0x110
+	{"f8", 272, 8, "vsr8", false},        // 0x110
+	{"vsr9", 288, 16, "", false},         // 0x120
+	{"f9", 288, 8, "vsr9", false},        // 0x120
+	{"vsr10", 304, 16, "", false},        // 0x130
+	{"f10", 304, 8, "vsr10", false},      // 0x130
+	{"vsr11", 320, 16, "", false},        // 0x140
+	{"f11", 320, 8, "vsr11", false},      // 0x140
+	{"vsr12", 336, 16, "", false},        // 0x150
+	{"f12", 336, 8, "vsr12", false},      // 0x150
+	{"vsr13", 352, 16, "", false},        // 0x160
+	{"f13", 352, 8, "vsr13", false},      // 0x160
+	{"vsr14", 368, 16, "", false},        // 0x170
+	{"f14", 368, 8, "vsr14", false},      // 0x170
+	{"vsr15", 384, 16, "", false},        // 0x180
+	{"f15", 384, 8, "vsr15", false},      // 0x180
+	{"vsr16", 400, 16, "", false},        // 0x190
+	{"f16", 400, 8, "vsr16", false},      // 0x190
+	{"vsr17", 416, 16, "", false},        // 0x1a0
+	{"f17", 416, 8, "vsr17", false},      // 0x1a0
+	{"vsr18", 432, 16, "", false},        // 0x1b0
+	{"f18", 432, 8, "vsr18", false},      // 0x1b0
+	{"vsr19", 448, 16, "", false},        // 0x1c0
+	{"f19", 448, 8, "vsr19", false},      // 0x1c0
+	{"vsr20", 464, 16, "", false},        // 0x1d0
+	{"f20", 464, 8, "vsr20", false},      // 0x1d0
+	{"vsr21", 480, 16, "", false},        // 0x1e0
+	{"f21", 480, 8, "vsr21", false},      // 0x1e0
+	{"vsr22", 496, 16, "", false},        // 0x1f0
+	{"f22", 496, 8, "vsr22", false},      // 0x1f0
+	{"vsr23", 512, 16, "", false},        // 0x200
+	{"f23", 512, 8, "vsr23", false},      // 0x200
+	{"vsr24", 528, 16, "", false},        // 0x210
+	{"f24", 528, 8, "vsr24", false},      // 0x210
+	{"vsr25", 544, 16, "", false},        // 0x220
+	{"f25", 544, 8, "vsr25", false},      // 0x220
+	{"vsr26", 560, 16, "", false},        // 0x230
+	{"f26", 560, 8, "vsr26", false},      // 0x230
+	{"vsr27", 576, 16, "", false},        // 0x240
+	{"f27", 576, 8, "vsr27", false},      // 0x240
+	{"vsr28", 592, 16, "", false},        // 0x250
+	{"f28", 592, 8, "vsr28", false},      // 0x250
+	{"vsr29", 608, 16, "", false},        // 0x260
+	{"f29", 608, 8, "vsr29", false},      // 0x260
+	{"vsr30", 624, 16, "", false},        // 0x270
+	{"f30", 624, 8, "vsr30", false},      // 0x270
+	{"vsr31", 640, 16, "", false},        // 0x280
+	{"f31", 640, 8, "vsr31", false},      // 0x280
+	{"vsr32", 656, 16, "", false},        // 0x290
+	{"v0", 656, 16, "vsr32", false},      // 0x290
+	{"vsr33", 672, 16, "", false},        // 0x2a0
+	{"v1", 672, 16, "vsr33", false},      // 0x2a0
+	{"vsr34", 688, 16, "", false},        // 0x2b0
+	{"v2", 688, 16, "vsr34", false},      // 0x2b0
+	{"vsr35", 704, 16, "", false},        // 0x2c0
+	{"v3", 704, 16, "vsr35", false},      // 0x2c0
+	{"vsr36", 720, 16, "", false},        // 0x2d0
+	{"v4", 720, 16, "vsr36", false},      // 0x2d0
+	{"vsr37", 736, 16, "", false},        // 0x2e0
+	{"v5", 736, 16, "vsr37", false},      // 0x2e0
+	{"vsr38", 752, 16, "", false},        // 0x2f0
+	{"v6", 752, 16, "vsr38", false},      // 0x2f0
+	{"vsr39", 768, 16, "", false},        // 0x300
+	{"v7", 768, 16, "vsr39", false},      // 0x300
+	{"vsr40", 784, 16, "", false},        // 0x310
+	{"v8", 784, 16, "vsr40", false},      // 0x310
+	{"vsr41", 800, 16, "", false},        // 0x320
+	{"v9", 800, 16, "vsr41", false},      // 0x320
+	{"vsr42", 816, 16, "", false},        // 0x330
+	{"v10", 816, 16, "vsr42", false},     // 0x330
+	{"vsr43", 832, 16, "", false},        // 0x340
+	{"v11", 832, 16, "vsr43", false},     // 0x340
+	{"vsr44", 848, 16, "", false},        // 0x350
+	{"v12", 848, 16, "vsr44", false},     // 0x350
+	{"vsr45", 864, 16, "", false},        // 0x360
+	{"v13", 864, 16, "vsr45", false},     // 0x360
+	{"vsr46", 880, 16, "", false},        // 0x370
+	{"v14", 880, 16, "vsr46", false},     // 0x370
+	{"vsr47", 896, 16, "", false},        // 0x380
+	{"v15", 896, 16, "vsr47", false},     // 0x380
+	{"vsr48", 912, 16, "", false},        // 0x390
+	{"v16", 912, 16, "vsr48", false},     // 0x390
+	{"vsr49", 928, 16, "", false},        // 0x3a0
+	{"v17", 928, 16, "vsr49", false},     // 0x3a0
+	{"vsr50", 944, 16, "", false},        // 0x3b0
+	{"v18", 944, 16, "vsr50", false},     // 0x3b0
+	{"vsr51", 960, 16, "", false},        // 0x3c0
+	{"v19", 960, 16, "vsr51", false},     // 0x3c0
+	{"vsr52", 976, 16, "", false},        // 0x3d0
+	{"v20", 976, 16, "vsr52", false},     // 0x3d0
+	{"vsr53", 992, 16, "", false},        // 0x3e0
+	{"v21", 992, 16, "vsr53", false},     // 0x3e0
+	{"vsr54", 1008, 16, "", false},       // 0x3f0
+	{"v22", 1008, 16, "vsr54", false},    // 0x3f0
+	{"vsr55", 1024, 16, "", false},       // 0x400
+	{"v23", 1024, 16, "vsr55", false},    // 0x400
+	{"vsr56", 1040, 16, "", false},       // 0x410
+	{"v24", 1040, 16, "vsr56", false},    // 0x410
+	{"vsr57", 1056, 16, "", false},       // 0x420
+	{"v25", 1056, 16, "vsr57", false},    // 0x420
+	{"vsr58", 1072, 16, "", false},       // 0x430
+	{"v26", 1072, 16, "vsr58", false},    // 0x430
+	{"vsr59", 1088, 16, "", false},       // 0x440
+	{"v27", 1088, 16, "vsr59", false},    // 0x440
+	{"vsr60", 1104, 16, "", false},       // 0x450
+	{"v28", 1104, 16, "vsr60", false},    // 0x450
+	{"vsr61", 1120, 16, "", false},       // 0x460
+	{"v29", 1120, 16, "vsr61", false},    // 0x460
+	{"vsr62", 1136, 16, "", false},       // 0x470
+	{"v30", 1136, 16, "vsr62", false},    // 0x470
+	{"vsr63", 1152, 16, "", false},       // 0x480
+	{"v31", 1152, 16, "vsr63", false},    // 0x480
+	{"cia", 1168, 4, "", false},          // 0x490
+	{"lr", 1172, 4, "", false},           // 0x494
+	{"ctr", 1176, 4, "", false},          // 0x498
+	{"xer_so", 1180, 1, "", false},       // 0x49c
+	{"xer_ov", 1181, 1, "", false},       // 0x49d
+	{"xer_ca", 1182, 1, "", false},       // 0x49e
+	{"xer_bc", 1183, 1, "", false},       // 0x49f
+	{"cr0_321", 1184, 1, "", false},      // 0x4a0
+	{"cr0_0", 1185, 1, "", false},        // 0x4a1
+	{"cr1_321", 1186, 1, "", false},      // 0x4a2
+	{"cr1_0", 1187, 1, "", false},        // 0x4a3
+	{"cr2_321", 1188, 1, "", false},      // 0x4a4
+	{"cr2_0", 1189, 1, "", false},        // 0x4a5
+	{"cr3_321", 1190, 1, "", false},      // 0x4a6
+	{"cr3_0", 1191, 1, "", false},        // 0x4a7
+	{"cr4_321", 1192, 1, "", false},      // 0x4a8
+	{"cr4_0", 1193, 1, "", false},        // 0x4a9
+	{"cr5_321", 1194, 1, "", false},      // 0x4aa
+	{"cr5_0", 1195, 1, "", false},        // 0x4ab
+	{"cr6_321", 1196, 1, "", false},      // 0x4ac
+	{"cr6_0", 1197, 1, "", false},        // 0x4ad
+	{"cr7_321", 1198, 1, "", false},      // 0x4ae
+	{"cr7_0", 1199, 1, "", false},        // 0x4af
+	{"fpround", 1200, 1, "", false},      // 0x4b0
+	{"dfpround", 1201, 1, "", false},     // 0x4b1
+	{"c_fpcc", 1202, 1, "", false},       // 0x4b2
+	{"vrsave", 1204, 4, "", false},       // 0x4b4
+	{"vscr", 1208, 4, "", false},         // 0x4b8
+	{"emnote", 1212, 4, "", true},        // 0x4bc
+	{"cmstart", 1216, 4, "", true},       // 0x4c0
+	{"cmlen", 1220, 4, "", true},         // 0x4c4
+	{"nraddr", 1224, 4, "", true},        // 0x4c8
+	{"nraddr_gpr2", 1228, 4, "", true},   // 0x4cc
+	{"redir_sp", 1232, 4, "", true},      // 0x4d0
+	{"redir_stack", 1236, 128, "", true}, // 0x4d4
+	{"ip_at_syscall", 1364, 4, "", true}, // 0x554
+	{"sprg3_ro", 1368, 4, "", false},     // 0x558
+	{"tfhar", 1376, 8, "", false},        // 0x560
+	{"texasr", 1384, 8, "", false},       // 0x568
+	{"tfiar", 1392, 8, "", false},        // 0x570
+	{"ppr", 1400, 8, "", false},          // 0x578
+	{"texasru", 1408, 4, "", false},      // 0x580
+	{"pspb", 1412, 4, "", false},         // 0x584
+}
+
+var ppc32RegisterAliases = map[string]string{
+	"sp": "r1",
+	"pc": "cia",
+}
+
+// ppc64Registers 是 VexGuestPPC64State 中的寄存器
+var ppc64Registers = []Register{
+	{"r0", 16, 8, "", false},             // 0x10
+	{"r1", 24, 8, "", false},             // 0x18
+	{"r2", 32, 8, "", false},             // 0x20
+	{"r3", 40, 8, "", false},             // 0x28
+	{"r4", 48, 8, "", false},             // 0x30
+	{"r5", 56, 8, "", false},             // 0x38
+	{"r6", 64, 8, "", false},             // 0x40
+	{"r7", 72, 8, "", false},             // 0x48
+	{"r8", 80, 8, "", false},             // 0x50
+	{"r9", 88, 8, "", false},             // 0x58
+	{"r10", 96, 8, "", false},            // 0x60
+	{"r11", 104, 8, "", false},           // 0x68
+	{"r12", 112, 8, "", false},           // 0x70
+	{"r13", 120, 8, "", false},           // 0x78
+	{"r14", 128, 8, "", false},           // 0x80
+	{"r15", 136, 8, "", false},           // 0x88
+	{"r16", 144, 8, "", false},           // 0x90
+	{"r17", 152, 8, "", false},           // 0x98
+	{"r18", 160, 8, "", false},           // 0xa0
+	{"r19", 168, 8, "", false},           // 0xa8
+	{"r20", 176, 8, "", false},           // 0xb0
+	{"r21", 184, 8, "", false},           // 0xb8
+	{"r22", 192, 8, "", false},           // 0xc0
+	{"r23", 200, 8, "", false},           // 0xc8
+	{"r24", 208, 8, "", false},           // 0xd0
+	{"r25", 216, 8, "", false},           // 0xd8
+	{"r26", 224, 8, "", false},           // 0xe0
+	{"r27", 232, 8, "", false},           // 0xe8
+	{"r28", 240, 8, "", false},           // 0xf0
+	{"r29", 248, 8, "", false},           // 0xf8
+	{"r30", 256, 8, "", false},           // 0x100
+	{"r31", 264, 8, "", false},           // 0x108
+	{"vsr0", 272, 16, "", false},         // 0x110
+	{"f0", 272, 8, "vsr0", false},        // 0x110
+	{"vsr1", 288, 16, "", false},         // 0x120
+	{"f1", 288, 8, "vsr1", false},        // 0x120
+	{"vsr2", 304, 16, "", false},         // 0x130
+	{"f2", 304, 8, "vsr2", false},        // 0x130
+	{"vsr3", 320, 16, "", false},         // 0x140
+	{"f3", 320, 8, "vsr3", false},        // 0x140
+	{"vsr4", 336, 16, "", false},         // 0x150
+	{"f4", 336, 8, "vsr4", false},        // 0x150
+	{"vsr5", 352, 16, "", false},         // 0x160
+	{"f5", 352, 8, "vsr5", false},        // 0x160
+	{"vsr6", 368, 16, "", false},         // 0x170
+	{"f6", 368, 8, "vsr6", false},        // 0x170
+	{"vsr7", 384, 16, "", false},         // 0x180
+	{"f7", 384, 8, "vsr7", false},        // 0x180
+	{"vsr8", 400, 16, "", false},         // 0x190
+	{"f8", 400, 8, "vsr8", false},        // 0x190
+	{"vsr9", 416, 16, "", false},         // 0x1a0
+	{"f9", 416, 8, "vsr9", false},        // 0x1a0
+	{"vsr10", 432, 16, "", false},        // 0x1b0
+	{"f10", 432, 8, "vsr10", false},      // 0x1b0
+	{"vsr11", 448, 16, "", false},        // 0x1c0
+	{"f11", 448, 8, "vsr11", false},      // 0x1c0
+	{"vsr12", 464, 16, "", false},        // 0x1d0
+	{"f12", 464, 8, "vsr12", false},      // 0x1d0
+	{"vsr13", 480, 16, "", false},        // 0x1e0
+	{"f13", 480, 8, "vsr13", false},      // 0x1e0
+	{"vsr14", 496, 16, "", false},        // 0x1f0
+	{"f14", 496, 8, "vsr14", false},      // 0x1f0
+	{"vsr15", 512, 16, "", false},        // 0x200
+	{"f15", 512, 8, "vsr15", false},      // 0x200
+	{"vsr16", 528, 16, "", false},        // 0x210
+	{"f16", 528, 8, "vsr16", false},      // 0x210
+	{"vsr17", 544, 16, "", false},        // 0x220
+	{"f17", 544, 8, "vsr17", false},      // 0x220
+	{"vsr18", 560, 16, "", false},        // 0x230
+	{"f18", 560, 8, "vsr18", false},      // 0x230
+	{"vsr19", 576, 16, "", false},        // 0x240
+	{"f19", 576, 8, "vsr19", false},      // 0x240
+	{"vsr20", 592, 16, "", false},        // 0x250
+	{"f20", 592, 8, "vsr20", false},      // 0x250
+	{"vsr21", 608, 16, "", false},        // 0x260
+	{"f21", 608, 8, "vsr21", false},      // 0x260
+	{"vsr22", 624, 16, "", false},        // 0x270
+	{"f22", 624, 8, "vsr22", false},      // 0x270
+	{"vsr23", 640, 16, "", false},        // 0x280
+	{"f23", 640, 8, "vsr23", false},      // 0x280
+	{"vsr24", 656, 16, "", false},        // 0x290
+	{"f24", 656, 8, "vsr24", false},      // 0x290
+	{"vsr25", 672, 16, "", false},        // 0x2a0
+	{"f25", 672, 8, "vsr25", false},      // 0x2a0
+	{"vsr26", 688, 16, "", false},        // 0x2b0
+	{"f26", 688, 8, "vsr26", false},      // 0x2b0
+	{"vsr27", 704, 16, "", false},        // 0x2c0
+	{"f27", 704, 8, "vsr27", false},      // 0x2c0
+	{"vsr28", 720, 16, "", false},        // 0x2d0
+	{"f28", 720, 8, "vsr28", false},      // 0x2d0
+	{"vsr29", 736, 16, "", false},        // 0x2e0
+	{"f29", 736, 8, "vsr29", false},      // 0x2e0
+	{"vsr30", 752, 16, "", false},        // 0x2f0
+	{"f30", 752, 8, "vsr30", false},      // 0x2f0
+	{"vsr31", 768, 16, "", false},        // 0x300
+	{"f31", 768, 8, "vsr31", false},      // 0x300
+	{"vsr32", 784, 16, "", false},        // 0x310
+	{"v0", 784, 16, "vsr32", false},      // 0x310
+	{"vsr33", 800, 16, "", false},        // 0x320
+	{"v1", 800, 16, "vsr33", false},      // 0x320
+	{"vsr34", 816, 16, "", false},        // 0x330
+	{"v2", 816, 16, "vsr34", false},      // 0x330
+	{"vsr35", 832, 16, "", false},        // 0x340
+	{"v3", 832, 16, "vsr35", false},      // 0x340
+	{"vsr36", 848, 16, "", false},        // 0x350
+	{"v4", 848, 16, "vsr36", false},      // 0x350
+	{"vsr37", 864, 16, "", false},        // 0x360
+	{"v5", 864, 16, "vsr37", false},      // 0x360
+	{"vsr38", 880, 16, "", false},        // 0x370
+	{"v6", 880, 16, "vsr38", false},      // 0x370
+	{"vsr39", 896, 16, "", false},        // 0x380
+	{"v7", 896, 16, "vsr39", false},      // 0x380
+	{"vsr40", 912, 16, "", false},        // 0x390
+	{"v8", 912, 16, "vsr40", false},      // 0x390
+	{"vsr41", 928, 16, "", false},        // 0x3a0
+	{"v9", 928, 16, "vsr41", false},      // 0x3a0
+	{"vsr42", 944, 16, "", false},        // 0x3b0
+	{"v10", 944, 16, "vsr42", false},     // 0x3b0
+	{"vsr43", 960, 16, "", false},        // 0x3c0
+	{"v11", 960, 16, "vsr43", false},     // 0x3c0
+	{"vsr44", 976, 16, "", false},        // 0x3d0
+	{"v12", 976, 16, "vsr44", false},     // 0x3d0
+	{"vsr45", 992, 16, "", false},        // 0x3e0
+	{"v13", 992, 16, "vsr45", false},     // 0x3e0
+	{"vsr46", 1008, 16, "", false},       // 0x3f0
+	{"v14", 1008, 16, "vsr46", false},    // 0x3f0
+	{"vsr47", 1024, 16, "", false},       // 0x400
+	{"v15", 1024, 16, "vsr47", false},    // 0x400
+	{"vsr48", 1040, 16, "", false},       // 0x410
+	{"v16", 1040, 16, "vsr48", false},    // 0x410
+	{"vsr49", 1056, 16, "", false},       // 0x420
+	{"v17", 1056, 16, "vsr49", false},    // 0x420
+	{"vsr50", 1072, 16, "", false},       // 0x430
+	{"v18", 1072, 16, "vsr50", false},    // 0x430
+	{"vsr51", 1088, 16, "", false},       // 0x440
+	{"v19", 1088, 16, "vsr51", false},    // 0x440
+	{"vsr52", 1104, 16, "", false},       // 0x450
+	{"v20", 1104, 16, "vsr52", false},    // 0x450
+	{"vsr53", 1120, 16, "", false},       // 0x460
+	{"v21", 1120, 16, "vsr53", false},    // 0x460
+	{"vsr54", 1136, 16, "", false},       // 0x470
+	{"v22", 1136, 16, "vsr54", false},    // 0x470
+	{"vsr55", 1152, 16, "", false},       // 0x480
+	{"v23", 1152, 16, "vsr55", false},    // 0x480
+	{"vsr56", 1168, 16, "", false},       // 0x490
+	{"v24", 1168, 16, "vsr56", false},    // 0x490
+	{"vsr57", 1184, 16, "", false},       // 0x4a0
+	{"v25", 1184, 16, "vsr57", false},    // 0x4a0
+	{"vsr58", 1200, 16, "", false},       // 0x4b0
+	{"v26", 1200, 16, "vsr58", false},    // 0x4b0
+	{"vsr59", 1216, 16, "", false},       // 0x4c0
+	{"v27", 1216, 16, "vsr59", false},    // 0x4c0
+	{"vsr60", 1232, 16, "", false},       // 0x4d0
+	{"v28", 1232, 16, "vsr60", false},    // 0x4d0
+	{"vsr61", 1248, 16, "", false},       // 0x4e0
+	{"v29", 1248, 16, "vsr61", false},    // 0x4e0
+	{"vsr62", 1264, 16, "", false},       // 0x4f0
+	{"v30", 1264, 16, "vsr62", false},    // 0x4f0
+	{"vsr63", 1280, 16, "", false},       // 0x500
+	{"v31", 1280, 16, "vsr63", false},    // 0x500
+	{"cia", 1296, 8, "", false},          // 0x510
+	{"lr", 1304, 8, "", false},           // 0x518
+	{"ctr", 1312, 8, "", false},          // 0x520
+	{"xer_so", 1320, 1, "", false},       // 0x528
+	{"xer_ov", 1321, 1, "", false},       // 0x529
+	{"xer_ca", 1322, 1, "", false},       // 0x52a
+	{"xer_bc", 1323, 1, "", false},       // 0x52b
+	{"cr0_321", 1324, 1, "", false},      // 0x52c
+	{"cr0_0", 1325, 1, "", false},        // 0x52d
+	{"cr1_321", 1326, 1, "", false},      // 0x52e
+	{"cr1_0", 1327, 1, "", false},        // 0x52f
+	{"cr2_321", 1328, 1, "", false},      // 0x530
+	{"cr2_0", 1329, 1, "", false},        // 0x531
+	{"cr3_321", 1330, 1, "", false},      // 0x532
+	{"cr3_0", 1331, 1, "", false},        // 0x533
+	{"cr4_321", 1332, 1, "", false},      // 0x534
+	{"cr4_0", 1333, 1, "", false},        // 0x535
+	{"cr5_321", 1334, 1, "", false},      // 0x536
+	{"cr5_0", 1335, 1, "", false},        // 0x537
+	{"cr6_321", 1336, 1, "", false},      // 0x538
+	{"cr6_0", 1337, 1, "", false},        // 0x539
+	{"cr7_321", 1338, 1, "", false},      // 0x53a
+	{"cr7_0", 1339, 1, "", false},        // 0x53b
+	{"fpround", 1340, 1, "", false},      // 0x53c
+	{"dfpround", 1341, 1, "", false},     // 0x53d
+	{"c_fpcc", 1342, 1, "", false},       // 0x53e
+	{"vrsave", 1344, 4, "", false},       // 0x540
+	{"vscr", 1348, 4, "", false},         // 0x544
+	{"emnote", 1352, 4, "", true},        // 0x548
+	{"cmstart", 1360, 8, "", true},       // 0x550
+	{"cmlen", 1368, 8, "", true},         // 0x558
+	{"nraddr", 1376, 8, "", true},        // 0x560
+	{"nraddr_gpr2", 1384, 8, "", true},   // 0x568
+	{"redir_sp", 1392, 8, "", true},      // 0x570
+	{"redir_stack", 1400, 256, "", true}, // 0x578
+	{"ip_at_syscall", 1656, 8, "", true}, // 0x678
+	{"sprg3_ro", 1664, 8, "", false},     // 0x680
+	{"tfhar", 1672, 8, "", false},        // 0x688
+	{"texasr", 1680, 8, "", false},       // 0x690
+	{"tfiar", 1688, 8, "", false},        // 0x698
+	{"ppr", 1696, 8, "", false},          // 0x6a0
+	{"texasru", 1704, 4, "", false},      // 0x6a8
+	{"pspb", 1708, 4, "", false},         // 0x6ac
+}
+
+var ppc64RegisterAliases = map[string]string{
+	"sp":  "r1",
+	"pc":  "cia",
+	"toc": "r2",
+}
+
+// s390xRegisters 是 VexGuestS390XState 中的寄存器
+var s390xRegisters = []Register{
+	{"a0", 0, 4, "", false},             // 0x0
+	{"a1", 4, 4, "", false},             // 0x4
+	{"a2", 8, 4, "", false},             // 0x8
+	{"a3", 12, 4, "", false},            // 0xc
+	{"a4", 16, 4, "", false},            // 0x10
+	{"a5", 20, 4, "", false},            // 0x14
+	{"a6", 24, 4, "", false},            // 0x18
+	{"a7", 28, 4, "", false},            // 0x1c
+	{"a8", 32, 4, "", false},            // 0x20
+	{"a9", 36, 4, "", false},            // 0x24
+	{"a10", 40, 4, "", false},           // 0x28
+	{"a11", 44, 4, "", false},           // 0x2c
+	{"a12", 48, 4, "", false},           // 0x30
+	{"a13", 52, 4, "", false},           // 0x34
+	{"a14", 56, 4, "", false},           // 0x38
+	{"a15", 60, 4, "", false},           // 0x3c
+	{"v0", 64, 16, "", false},           // 0x40
+	{"f0", 64, 8, "v0", false},          // 0x40
+	{"v1", 80, 16, "", false},           // 0x50
+	{"f1", 80, 8, "v1", false},          // 0x50
+	{"v2", 96, 16, "", false},           // 0x60
+	{"f2", 96, 8, "v2", false},          // 0x60
+	{"v3", 112, 16, "", false},          // 0x70
+	{"f3", 112, 8, "v3", false},         // 0x70
+	{"v4", 128, 16, "", false},          // 0x80
+	{"f4", 128, 8, "v4", false},         // 0x80
+	{"v5", 144, 16, "", false},          // 0x90
+	{"f5", 144, 8, "v5", false},         // 0x90
+	{"v6", 160, 16, "", false},          // 0xa0
+	{"f6", 160, 8, "v6", false},         // 0xa0
+	{"v7", 176, 16, "", false},          // 0xb0
+	{"f7", 176, 8, "v7", false},         // 0xb0
+	{"v8", 192, 16, "", false},          // 0xc0
+	{"f8", 192, 8, "v8", false},         // 0xc0
+	{"v9", 208, 16, "", false},          // 0xd0
+	{"f9", 208, 8, "v9", false},         // 0xd0
+	{"v10", 224, 16, "", false},         // 0xe0
+	{"f10", 224, 8, "v10", false},       // 0xe0
+	{"v11", 240, 16, "", false},         // 0xf0
+	{"f11", 240, 8, "v11", false},       // 0xf0
+	{"v12", 256, 16, "", false},         // 0x100
+	{"f12", 256, 8, "v12", false},       // 0x100
+	{"v13", 272, 16, "", false},         // 0x110
+	{"f13", 272, 8, "v13", false},       // 0x110
+	{"v14", 288, 16, "", false},         // 0x120
+	{"f14", 288, 8, "v14", false},       // 0x120
+	{"v15", 304, 16, "", false},         // 0x130
+	{"f15", 304, 8, "v15", false},       // 0x130
+	{"v16", 320, 16, "", false},         // 0x140
+	{"v17", 336, 16, "", false},         // 0x150
+	{"v18", 352, 16, "", false},         // 0x160
+	{"v19", 368, 16, "", false},         // 0x170
+	{"v20", 384, 16, "", false},         // 0x180
+	{"v21", 400, 16, "", false},         // 0x190
+	{"v22", 416, 16, "", false},         // 0x1a0
+	{"v23", 432, 16, "", false},         // 0x1b0
+	{"v24", 448, 16, "", false},         // 0x1c0
+	{"v25", 464, 16, "", false},         // 0x1d0
+	{"v26", 480, 16, "", false},         // 0x1e0
+	{"v27", 496, 16, "", false},         // 0x1f0
+	{"v28", 512, 16, "", false},         // 0x200
+	{"v29", 528, 16, "", false},         // 0x210
+	{"v30", 544, 16, "", false},         // 0x220
+	{"v31", 560, 16, "", false},         // 0x230
+	{"r0", 576, 8, "", false},           // 0x240
+	{"r1", 584, 8, "", false},           // 0x248
+	{"r2", 592, 8, "", false},           // 0x250
+	{"r3", 600, 8, "", false},           // 0x258
+	{"r4", 608, 8, "", false},           // 0x260
+	{"r5", 616, 8, "", false},           // 0x268
+	{"r6", 624, 8, "", false},           // 0x270
+	{"r7", 632, 8, "", false},           // 0x278
+	{"r8", 640, 8, "", false},           // 0x280
+	{"r9", 648, 8, "", false},           // 0x288
+	{"r10", 656, 8, "", false},          // 0x290
+	{"r11", 664, 8, "", false},          // 0x298
+	{"r12", 672, 8, "", false},          // 0x2a0
+	{"r13", 680, 8, "", false},          // 0x2a8
+	{"r14", 688, 8, "", false},          // 0x2b0
+	{"r15", 696, 8, "", false},          // 0x2b8
+	{"counter", 704, 8, "", true},       // 0x2c0
+	{"fpc", 712, 4, "", false},          // 0x2c8
+	{"ia", 720, 8, "", false},           // 0x2d0
+	{"sysno", 728, 8, "", true},         // 0x2d8
+	{"cc_op", 736, 8, "", true},         // 0x2e0
+	{"cc_dep1", 744, 8, "", true},       // 0x2e8
+	{"cc_dep2", 752, 8, "", true},       // 0x2f0
+	{"cc_ndep", 760, 8, "", true},       // 0x2f8
+	{"nraddr", 768, 8, "", true},        // 0x300
+	{"cmstart", 776, 8, "", true},       // 0x308
+	{"cmlen", 784, 8, "", true},         // 0x310
+	{"ip_at_syscall", 792, 8, "", true}, // 0x318
+	{"emnote", 800, 4, "", true},        // 0x320
+}
+
+var s390xRegisterAliases = map[string]string{
+	"sp": "r15",
+	"lr": "r14",
+	"pc": "ia",
+}
+
+// mips32Registers 是 VexGuestMIPS32State 中的寄存器
+var mips32Registers = []Register{
+	{"r0", 8, 4, "", false},             // 0x8
+	{"r1", 12, 4, "", false},            // 0xc
+	{"r2", 16, 4, "", false},            // 0x10
+	{"r3", 20, 4, "", false},            // 0x14
+	{"r4", 24, 4, "", false},            // 0x18
+	{"r5", 28, 4, "", false},            // 0x1c
+	{"r6", 32, 4, "", false},            // 0x20
+	{"r7", 36, 4, "", false},            // 0x24
+	{"r8", 40, 4, "", false},            // 0x28
+	{"r9", 44, 4, "", false},            // 0x2c
+	{"r10", 48, 4, "", false},           // 0x30
+	{"r11", 52, 4, "", false},           // 0x34
+	{"r12", 56, 4, "", false},           // 0x38
+	{"r13", 60, 4, "", false},           // 0x3c
+	{"r14", 64, 4, "", false},           // 0x40
+	{"r15", 68, 4, "", false},           // 0x44
+	{"r16", 72, 4, "", false},           // 0x48
+	{"r17", 76, 4, "", false},           // 0x4c
+	{"r18", 80, 4, "", false},           // 0x50
+	{"r19", 84, 4, "", false},           // 0x54
+	{"r20", 88, 4, "", false},           // 0x58
+	{"r21", 92, 4, "", false},           // 0x5c
+	{"r22", 96, 4, "", false},           // 0x60
+	{"r23", 100, 4, "", false},          // 0x64
+	{"r24", 104, 4, "", false},          // 0x68
+	{"r25", 108, 4, "", false},          // 0x6c
+	{"r26", 112, 4, "", false},          // 0x70
+	{"r27", 116, 4, "", false},          // 0x74
+	{"r28", 120, 4, "", false},          // 0x78
+	{"r29", 124, 4, "", false},          // 0x7c
+	{"r30", 128, 4, "", false},          // 0x80
+	{"r31", 132, 4, "", false},          // 0x84
+	{"pc", 136, 4, "", false},           // 0x88
+	{"hi", 140, 4, "", false},           // 0x8c
+	{"lo", 144, 4, "", false},           // 0x90
+	{"f0", 152, 8, "", false},           // 0x98
+	{"f1", 160, 8, "", false},           // 0xa0
+	{"f2", 168, 8, "", false},           // 0xa8
+	{"f3", 176, 8, "", false},           // 0xb0
+	{"f4", 184, 8, "", false},           // 0xb8
+	{"f5", 192, 8, "", false},           // 0xc0
+	{"f6", 200, 8, "", false},           // 0xc8
+	{"f7", 208, 8, "", false},           // 0xd0
+	{"f8", 216, 8, "", false},           // 0xd8
+	{"f9", 224, 8, "", false},           // 0xe0
+	{"f10", 232, 8, "", false},          // 0xe8
+	{"f11", 240, 8, "", false},          // 0xf0
+	{"f12", 248, 8, "", false},          // 0xf8
+	{"f13", 256, 8, "", false},          // 0x100
+	{"f14", 264, 8, "", false},          // 0x108
+	{"f15", 272, 8, "", false},          // 0x110
+	{"f16", 280, 8, "", false},          // 0x118
+	{"f17", 288, 8, "", false},          // 0x120
+	{"f18", 296, 8, "", false},          // 0x128
+	{"f19", 304, 8, "", false},          // 0x130
+	{"f20", 312, 8, "", false},          // 0x138
+	{"f21", 320, 8, "", false},          // 0x140
+	{"f22", 328, 8, "", false},          // 0x148
+	{"f23", 336, 8, "", false},          // 0x150
+	{"f24", 344, 8, "", false},          // 0x158
+	{"f25", 352, 8, "", false},          // 0x160
+	{"f26", 360, 8, "", false},          // 0x168
+	{"f27", 368, 8, "", false},          // 0x170
+	{"f28", 376, 8, "", false},          // 0x178
+	{"f29", 384, 8, "", false},          // 0x180
+	{"f30", 392, 8, "", false},          // 0x188
+	{"f31", 400, 8, "", false},          // 0x190
+	{"fir", 408, 4, "", false},          // 0x198
+	{"fccr", 412, 4, "", false},         // 0x19c
+	{"fexr", 416, 4, "", false},         // 0x1a0
+	{"fenr", 420, 4, "", false},         // 0x1a4
+	{"fcsr", 424, 4, "", false},         // 0x1a8
+	{"ulr", 428, 4, "", false},          // 0x1ac
+	{"emnote", 432, 4, "", true},        // 0x1b0
+	{"cmstart", 436, 4, "", true},       // 0x1b4
+	{"cmlen", 440, 4, "", true},         // 0x1b8
+	{"nraddr", 444, 4, "", true},        // 0x1bc
+	{"cond", 448, 4, "", true},          // 0x1c0
+	{"dspcontrol", 452, 4, "", false},   // 0x1c4
+	{"ac0", 456, 8, "", false},          // 0x1c8
+	{"ac1", 464, 8, "", false},          // 0x1d0
+	{"ac2", 472, 8, "", false},          // 0x1d8
+	{"ac3", 480, 8, "", false},          // 0x1e0
+	{"cp0_status", 488, 4, "", false},   // 0x1e8
+	{"ip_at_syscall", 492, 4, "", true}, // 0x1ec
+}
+
+var mips32RegisterAliases = map[string]string{
+	"zero": "r0",
+	"at":   "r1",
+	"v0":   "r2",
+	"v1":   "r3",
+	"a0":   "r4",
+	"a1":   "r5",
+	"a2":   "r6",
+	"a3":   "r7",
+	"t0":   "r8",
+	"t1":   "r9",
+	"t2":   "r10",
+	"t3":   "r11",
+	"t4":   "r12",
+	"t5":   "r13",
+	"t6":   "r14",
+	"t7":   "r15",
+	"s0":   "r16",
+	"s1":   "r17",
+	"s2":   "r18",
+	"s3":   "r19",
+	"s4":   "r20",
+	"s5":   "r21",
+	"s6":   "r22",
+	"s7":   "r23",
+	"t8":   "r24",
+	"t9":   "r25",
+	"k0":   "r26",
+	"k1":   "r27",
+	"gp":   "r28",
+	"sp":   "r29",
+	"fp":   "r30",
+	"ra":   "r31",
+	"s8":   "r30",
+}
+
+// mips64Registers 是 VexGuestMIPS64State 中的寄存器
+var mips64Registers = []Register{
+	{"r0", 16, 8, "", false},            // 0x10
+	{"r1", 24, 8, "", false},            // 0x18
+	{"r2", 32, 8, "", false},            // 0x20
+	{"r3", 40, 8, "", false},            // 0x28
+	{"r4", 48, 8, "", false},            // 0x30
+	{"r5", 56, 8, "", false},            // 0x38
+	{"r6", 64, 8, "", false},            // 0x40
+	{"r7", 72, 8, "", false},            // 0x48
+	{"r8", 80, 8, "", false},            // 0x50
+	{"r9", 88, 8, "", false},            // 0x58
+	{"r10", 96, 8, "", false},           // 0x60
+	{"r11", 104, 8, "", false},          // 0x68
+	{"r12", 112, 8, "", false},          // 0x70
+	{"r13", 120, 8, "", false},          // 0x78
+	{"r14", 128, 8, "", false},          // 0x80
+	{"r15", 136, 8, "", false},          // 0x88
+	{"r16", 144, 8, "", false},          // 0x90
+	{"r17", 152, 8, "", false},          // 0x98
+	{"r18", 160, 8, "", false},          // 0xa0
+	{"r19", 168, 8, "", false},          // 0xa8
+	{"r20", 176, 8, "", false},          // 0xb0
+	{"r21", 184, 8, "", false},          // 0xb8
+	{"r22", 192, 8, "", false},          // 0xc0
+	{"r23", 200, 8, "", false},          // 0xc8
+	{"r24", 208, 8, "", false},          // 0xd0
+	{"r25", 216, 8, "", false},          // 0xd8
+	{"r26", 224, 8, "", false},          // 0xe0
+	{"r27", 232, 8, "", false},          // 0xe8
+	{"r28", 240, 8, "", false},          // 0xf0
+	{"r29", 248, 8, "", false},          // 0xf8
+	{"r30", 256, 8, "", false},          // 0x100
+	{"r31", 264, 8, "", false},          // 0x108
+	{"pc", 272, 8, "", false},           // 0x110
+	{"hi", 280, 8, "", false},           // 0x118
+	{"lo", 288, 8, "", false},           // 0x120
+	{"f0", 296, 8, "", false},           // 0x128
+	{"f1", 304, 8, "", false},           // 0x130
+	{"f2", 312, 8, "", false},           // 0x138
+	{"f3", 320, 8, "", false},           // 0x140
+	{"f4", 328, 8, "", false},           // 0x148
+	{"f5", 336, 8, "", false},           // 0x150
+	{"f6", 344, 8, "", false},           // 0x158
+	{"f7", 352, 8, "", false},           // 0x160
+	{"f8", 360, 8, "", false},           // 0x168
+	{"f9", 368, 8, "", false},           // 0x170
+	{"f10", 376, 8, "", false},          // 0x178
+	{"f11", 384, 8, "", false},          // 0x180
+	{"f12", 392, 8, "", false},          // 0x188
+	{"f13", 400, 8, "", false},          // 0x190
+	{"f14", 408, 8, "", false},          // 0x198
+	{"f15", 416, 8, "", false},          // 0x1a0
+	{"f16", 424, 8, "", false},          // 0x1a8
+	{"f17", 432, 8, "", false},          // 0x1b0
+	{"f18", 440, 8, "", false},          // 0x1b8
+	{"f19", 448, 8, "", false},          // 0x1c0
+	{"f20", 456, 8, "", false},          // 0x1c8
+	{"f21", 464, 8, "", false},          // 0x1d0
+	{"f22", 472, 8, "", false},          // 0x1d8
+	{"f23", 480, 8, "", false},          // 0x1e0
+	{"f24", 488, 8, "", false},          // 0x1e8
+	{"f25", 496, 8, "", false},          // 0x1f0
+	{"f26", 504, 8, "", false},          // 0x1f8
+	{"f27", 512, 8, "", false},          // 0x200
+	{"f28", 520, 8, "", false},          // 0x208
+	{"f29", 528, 8, "", false},          // 0x210
+	{"f30", 536, 8, "", false},          // 0x218
+	{"f31", 544, 8, "", false},          // 0x220
+	{"fir", 552, 4, "", false},          // 0x228
+	{"fccr", 556, 4, "", false},         // 0x22c
+	{"fexr", 560, 4, "", false},         // 0x230
+	{"fenr", 564, 4, "", false},         // 0x234
+	{"fcsr", 568, 4, "", false},         // 0x238
+	{"cp0_status", 572, 4, "", false},   // 0x23c
+	{"ulr", 576, 8, "", false},          // 0x240
+	{"emnote", 584, 4, "", true},        // 0x248
+	{"cond", 588, 4, "", true},          // 0x24c
+	{"cmstart", 592, 8, "", true},       // 0x250
+	{"cmlen", 600, 8, "", true},         // 0x258
+	{"nraddr", 608, 8, "", true},        // 0x260
+	{"ip_at_syscall", 616, 8, "", true}, // 0x268
+}
+
+var mips64RegisterAliases = map[string]string{
+	"zero": "r0",
+	"at":   "r1",
+	"v0":   "r2",
+	"v1":   "r3",
+	"a0":   "r4",
+	"a1":   "r5",
+	"a2":   "r6",
+	"a3":   "r7",
+	"t0":   "r8",
+	"t1":   "r9",
+	"t2":   "r10",
+	"t3":   "r11",
+	"t4":   "r12",
+	"t5":   "r13",
+	"t6":   "r14",
+	"t7":   "r15",
+	"s0":   "r16",
+	"s1":   "r17",
+	"s2":   "r18",
+	"s3":   "r19",
+	"s4":   "r20",
+	"s5":   "r21",
+	"s6":   "r22",
+	"s7":   "r23",
+	"t8":   "r24",
+	"t9":   "r25",
+	"k0":   "r26",
+	"k1":   "r27",
+	"gp":   "r28",
+	"sp":   "r29",
+	"fp":   "r30",
+	"ra":   "r31",
+	"s8":   "r30",
+}
+
+// riscv64Registers 是 VexGuestRISCV64State 中的寄存器
+var riscv64Registers = []Register{
+	{"x0", 16, 8, "", false},        // 0x10
+	{"x1", 24, 8, "", false},        // 0x18
+	{"x2", 32, 8, "", false},        // 0x20
+	{"x3", 40, 8, "", false},        // 0x28
+	{"x4", 48, 8, "", false},        // 0x30
+	{"x5", 56, 8, "", false},        // 0x38
+	{"x6", 64, 8, "", false},        // 0x40
+	{"x7", 72, 8, "", false},        // 0x48
+	{"x8", 80, 8, "", false},        // 0x50
+	{"x9", 88, 8, "", false},        // 0x58
+	{"x10", 96, 8, "", false},       // 0x60
+	{"x11", 104, 8, "", false},      // 0x68
+	{"x12", 112, 8, "", false},      // 0x70
+	{"x13", 120, 8, "", false},      // 0x78
+	{"x14", 128, 8, "", false},      // 0x80
+	{"x15", 136, 8, "", false},      // 0x88
+	{"x16", 144, 8, "", false},      // 0x90
+	{"x17", 152, 8, "", false},      // 0x98
+	{"x18", 160, 8, "", false},      // 0xa0
+	{"x19", 168, 8, "", false},      // 0xa8
+	{"x20", 176, 8, "", false},      // 0xb0
+	{"x21", 184, 8, "", false},      // 0xb8
+	{"x22", 192, 8, "", false},      // 0xc0
+	{"x23", 200, 8, "", false},      // 0xc8
+	{"x24", 208, 8, "", false},      // 0xd0
+	{"x25", 216, 8, "", false},      // 0xd8
+	{"x26", 224, 8, "", false},      // 0xe0
+	{"x27", 232, 8, "", false},      // 0xe8
+	{"x28", 240, 8, "", false},      // 0xf0
+	{"x29", 248, 8, "", false},      // 0xf8
+	{"x30", 256, 8, "", false},      // 0x100
+	{"x31", 264, 8, "", false},      // 0x108
+	{"pc", 272, 8, "", false},       // 0x110
+	{"f0", 280, 8, "", false},       // 0x118
+	{"f1", 288, 8, "", false},       // 0x120
+	{"f2", 296, 8, "", false},       // 0x128
+	{"f3", 304, 8, "", false},       // 0x130
+	{"f4", 312, 8, "", false},       // 0x138
+	{"f5", 320, 8, "", false},       // 0x140
+	{"f6", 328, 8, "", false},       // 0x148
+	{"f7", 336, 8, "", false},       // 0x150
+	{"f8", 344, 8, "", false},       // 0x158
+	{"f9", 352, 8, "", false},       // 0x160
+	{"f10", 360, 8, "", false},      // 0x168
+	{"f11", 368, 8, "", false},      // 0x170
+	{"f12", 376, 8, "", false},      // 0x178
+	{"f13", 384, 8, "", false},      // 0x180
+	{"f14", 392, 8, "", false},      // 0x188
+	{"f15", 400, 8, "", false},      // 0x190
+	{"f16", 408, 8, "", false},      // 0x198
+	{"f17", 416, 8, "", false},      // 0x1a0
+	{"f18", 424, 8, "", false},      // 0x1a8
+	{"f19", 432, 8, "", false},      // 0x1b0
+	{"f20", 440, 8, "", false},      // 0x1b8
+	{"f21", 448, 8, "", false},      // 0x1c0
+	{"f22", 456, 8, "", false},      // 0x1c8
+	{"f23", 464, 8, "", false},      // 0x1d0
+	{"f24", 472, 8, "", false},      // 0x1d8
+	{"f25", 480, 8, "", false},      // 0x1e0
+	{"f26", 488, 8, "", false},      // 0x1e8
+	{"f27", 496, 8, "", false},      // 0x1f0
+	{"f28", 504, 8, "", false},      // 0x1f8
+	{"f29", 512, 8, "", false},      // 0x200
+	{"f30", 520, 8, "", false},      // 0x208
+	{"f31", 528, 8, "", false},      // 0x210
+	{"fcsr", 536, 4, "", false},     // 0x218
+	{"emnote", 540, 4, "", true},    // 0x21c
+	{"cmstart", 544, 8, "", true},   // 0x220
+	{"cmlen", 552, 8, "", true},     // 0x228
+	{"nraddr", 560, 8, "", true},    // 0x230
+	{"llsc_size", 568, 8, "", true}, // 0x238
+	{"llsc_addr", 576, 8, "", true}, // 0x240
+	{"llsc_data", 584, 8, "", true}, // 0x248
+}
+
+var riscv64RegisterAliases = map[string]string{
+	"zero": "x0",
+	"ra":   "x1",
+	"sp":   "x2",
+	"gp":   "x3",
+	"tp":   "x4",
+	"t0":   "x5",
+	"t1":   "x6",
+	"t2":   "x7",
+	"s0":   "x8",
+	"s1":   "x9",
+	"a0":   "x10",
+	"a1":   "x11",
+	"a2":   "x12",
+	"a3":   "x13",
+	"a4":   "x14",
+	"a5":   "x15",
+	"a6":   "x16",
+	"a7":   "x17",
+	"s2":   "x18",
+	"s3":   "x19",
+	"s4":   "x20",
+	"s5":   "x21",
+	"s6":   "x22",
+	"s7":   "x23",
+	"s8":   "x24",
+	"s9":   "x25",
+	"s10":  "x26",
+	"s11":  "x27",
+	"t3":   "x28",
+	"t4":   "x29",
+	"t5":   "x30",
+	"t6":   "x31",
+	"ft0":  "f0",
+	"ft1":  "f1",
+	"ft2":  "f2",
+	"ft3":  "f3",
+	"ft4":  "f4",
+	"ft5":  "f5",
+	"ft6":  "f6",
+	"ft7":  "f7",
+	"fs0":  "f8",
+	"fs1":  "f9",
+	"fa0":  "f10",
+	"fa1":  "f11",
+	"fa2":  "f12",
+	"fa3":  "f13",
+	"fa4":  "f14",
+	"fa5":  "f15",
+	"fa6":  "f16",
+	"fa7":  "f17",
+	"fs2":  "f18",
+	"fs3":  "f19",
+	"fs4":  "f20",
+	"fs5":  "f21",
+	"fs6":  "f22",
+	"fs7":  "f23",
+	"fs8":  "f24",
+	"fs9":  "f25",
+	"fs10": "f26",
+	"fs11": "f27",
+	"ft8":  "f28",
+	"ft9":  "f29",
+	"ft10": "f30",
+	"ft11": "f31",
+	"fp":   "x8",
+}
+
+// tilegxRegisters 是 VexGuestTILEGXState 中的寄存器
+var tilegxRegisters = []Register{
+	{"r0", 0, 8, "", false},            // 0x0
+	{"r1", 8, 8, "", false},            // 0x8
+	{"r2", 16, 8, "", false},           // 0x10
+	{"r3", 24, 8, "", false},           // 0x18
+	{"r4", 32, 8, "", false},           // 0x20
+	{"r5", 40, 8, "", false},           // 0x28
+	{"r6", 48, 8, "", false},           // 0x30
+	{"r7", 56, 8, "", false},           // 0x38
+	{"r8", 64, 8, "", false},           // 0x40
+	{"r9", 72, 8, "", false},           // 0x48
+	{"r10", 80, 8, "", false},          // 0x50
+	{"r11", 88, 8, "", false},          // 0x58
+	{"r12", 96, 8, "", false},          // 0x60
+	{"r13", 104, 8, "", false},         // 0x68
+	{"r14", 112, 8, "", false},         // 0x70
+	{"r15", 120, 8, "", false},         // 0x78
+	{"r16", 128, 8, "", false},         // 0x80
+	{"r17", 136, 8, "", false},         // 0x88
+	{"r18", 144, 8, "", false},         // 0x90
+	{"r19", 152, 8, "", false},         // 0x98
+	{"r20", 160, 8, "", false},         // 0xa0
+	{"r21", 168, 8, "", false},         // 0xa8
+	{"r22", 176, 8, "", false},         // 0xb0
+	{"r23", 184, 8, "", false},         // 0xb8
+	{"r24", 192, 8, "", false},         // 0xc0
+	{"r25", 200, 8, "", false},         // 0xc8
+	{"r26", 208, 8, "", false},         // 0xd0
+	{"r27", 216, 8, "", false},         // 0xd8
+	{"r28", 224, 8, "", false},         // 0xe0
+	{"r29", 232, 8, "", false},         // 0xe8
+	{"r30", 240, 8, "", false},         // 0xf0
+	{"r31", 248, 8, "", false},         // 0xf8
+	{"r32", 256, 8, "", false},         // 0x100
+	{"r33", 264, 8, "", false},         // 0x108
+	{"r34", 272, 8, "", false},         // 0x110
+	{"r35", 280, 8, "", false},         // 0x118
+	{"r36", 288, 8, "", false},         // 0x120
+	{"r37", 296, 8, "", false},         // 0x128
+	{"r38", 304, 8, "", false},         // 0x130
+	{"r39", 312, 8, "", false},         // 0x138
+	{"r40", 320, 8, "", false},         // 0x140
+	{"r41", 328, 8, "", false},         // 0x148
+	{"r42", 336, 8, "", false},         // 0x150
+	{"r43", 344, 8, "", false},         // 0x158
+	{"r44", 352, 8, "", false},         // 0x160
+	{"r45", 360, 8, "", false},         // 0x168
+	{"r46", 368, 8, "", false},         // 0x170
+	{"r47", 376, 8, "", false},         // 0x178
+	{"r48", 384, 8, "", false},         // 0x180
+	{"r49", 392, 8, "", false},         // 0x188
+	{"r50", 400, 8, "", false},         // 0x190
+	{"r51", 408, 8, "", false},         // 0x198
+	{"r52", 416, 8, "", false},         // 0x1a0
+	{"r53", 424, 8, "", false},         // 0x1a8
+	{"r54", 432, 8, "", false},         // 0x1b0
+	{"r55", 440, 8, "", false},         // 0x1b8
+	{"r56", 448, 8, "", false},         // 0x1c0
+	{"r57", 456, 8, "", false},         // 0x1c8
+	{"r58", 464, 8, "", false},         // 0x1d0
+	{"r59", 472, 8, "", false},         // 0x1d8
+	{"r60", 480, 8, "", false},         // 0x1e0
+	{"r61", 488, 8, "", false},         // 0x1e8
+	{"r62", 496, 8, "", false},         // 0x1f0
+	{"r63", 504, 8, "", false},         // 0x1f8
+	{"pc", 512, 8, "", false},          // 0x200
+	{"spare", 520, 8, "", true},        // 0x208
+	{"emnote", 528, 8, "", true},       // 0x210
+	{"cmstart", 536, 8, "", true},      // 0x218
+	{"cmlen", 544, 8, "", true},        // 0x220
+	{"nraddr", 552, 8, "", true},       // 0x228
+	{"cmpexch", 560, 8, "", true},      // 0x230
+	{"zero", 568, 8, "", true},         // 0x238
+	{"ex_context_0", 576, 8, "", true}, // 0x240
+	{"ex_context_1", 584, 8, "", true}, // 0x248
+	{"cond", 608, 8, "", true},         // 0x260
+}
+
+var tilegxRegisterAliases = map[string]string{
+	"sp": "r54",
+	"lr": "r55",
+}
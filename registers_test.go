@@ -0,0 +1,150 @@
+package vex_go
+
+import "testing"
+
+func TestRegisterLookup(t *testing.T) {
+	cases := []struct {
+		arch   VexArch
+		name   string
+		offset int32
+		size   int32
+	}{
+		{VexArchAMD64, "rax", 16, 8},
+		{VexArchAMD64, "EAX", 16, 4},
+		{VexArchAMD64, "ah", 17, 1},
+		{VexArchAMD64, "xmm1", 256, 16},
+		{VexArchX86, "esp", 24, 4},
+		{VexArchARM, "lr", 64, 4},
+		{VexArchARM, "d3", 152, 8},
+		{VexArchARM64, "w1", 24, 4},
+		{VexArchARM64, "fp", 248, 8},
+		{VexArchPPC64, "f1", 288, 8},
+		{VexArchS390X, "sp", 696, 8},
+		{VexArchRISCV64, "a0", 96, 8},
+		{VexArchMIPS32, "ra", 132, 4},
+	}
+	for _, c := range cases {
+		r, ok := LookupRegister(c.arch, c.name)
+		if !ok || r.Offset != c.offset || r.Size != c.size {
+			t.Errorf("%v %s: got %+v", c.arch, c.name, r)
+		}
+	}
+	if name, ok := RegisterName(VexArchAMD64, 17, 1); !ok || name != "ah" {
+		t.Errorf("unexpected name %q", name)
+	}
+	if name, ok := RegisterName(VexArchARM64, 256, 8); !ok || name != "x30" {
+		t.Errorf("unexpected name %q", name)
+	}
+	if _, ok := RegisterOffset(VexArchAMD64, "x0"); ok {
+		t.Error("x0 is not an AMD64 register")
+	}
+}
+
+// 各架构描述中的 IP 偏移应与 VEX 提升结果中的 OffsIP 一致
+func TestRegisterTablesMatchVex(t *testing.T) {
+	VexInit()
+	cases := []struct {
+		arch VexArch
+		en   VexEndness
+		mc   []byte
+	}{
+		{VexArchX86, VexEndnessLE, []byte{0x90}},
+		{VexArchAMD64, VexEndnessLE, []byte{0x90}},
+		{VexArchARM, VexEndnessLE, []byte{0x00, 0xf0, 0x20, 0xe3}},
+		{VexArchARM64, VexEndnessLE, []byte{0x1f, 0x20, 0x03, 0xd5}},
+		{VexArchPPC32, VexEndnessBE, []byte{0x60, 0x00, 0x00, 0x00}},
+		{VexArchPPC64, VexEndnessBE, []byte{0x60, 0x00, 0x00, 0x00}},
+		{VexArchS390X, VexEndnessBE, []byte{0x07, 0x07}},
+		{VexArchMIPS32, VexEndnessLE, []byte{0x00, 0x00, 0x00, 0x00}},
+		{VexArchMIPS64, VexEndnessLE, []byte{0x00, 0x00, 0x00, 0x00}},
+		{VexArchRISCV64, VexEndnessLE, []byte{0x13, 0x00, 0x00, 0x00}},
+	}
+	for _, c := range cases {
+		r, err := VexLift(c.arch, c.mc, 0x1000, c.en, nil)
+		if err != nil {
+			t.Errorf("%v: %v", c.arch, err)
+			continue
+		}
+		if ip := GetArchDescriptor(c.arch).IP; ip != r.IRSB.OffsIP {
+			t.Errorf("%v: IP at %d, VEX uses %d", c.arch, ip, r.IRSB.OffsIP)
+		}
+	}
+}
+
+func TestGuestLayout(t *testing.T) {
+	l := GetGuestLayout(VexArchAMD64)
+	v, ok := l.Lookup(17, 1)
+	if !ok || v.Register.Name != "rax" || v.Name() != "ah" || v.Pseudo() {
+		t.Fatalf("unexpected view %+v", v)
+	}
+	if v, ok = l.Lookup(18, 2); !ok || v.Name() != "rax+2" {
+		t.Fatalf("unexpected view %+v", v)
+	}
+	if v, ok = l.LookupType(144, ItyI64); !ok || v.Name() != "cc_op" || !v.Pseudo() {
+		t.Fatalf("unexpected view %+v", v)
+	}
+	if _, ok = l.Lookup(20, 8); ok {
+		t.Fatal("access spanning rax and rcx should not resolve")
+	}
+	if v, ok = GetGuestLayout(VexArchARM64).LookupType(256, ItyI32); !ok || v.Name() != "w30" || v.Register.Name != "x30" {
+		t.Fatalf("unexpected view %+v", v)
+	}
+
+	for arch, gl := range guestLayouts {
+		if gl.StateSize == 0 {
+			continue
+		}
+		for _, r := range gl.full {
+			if r.Offset+r.Size > gl.StateSize {
+				t.Errorf("%v: %s at %d+%d outside guest state of %d bytes", arch, r.Name, r.Offset, r.Size, gl.StateSize)
+			}
+		}
+	}
+
+	// ARM64 客户机状态中除开头的 host_EvC_*、EMNOTE 之后的对齐与末尾填充外，每个字节都属于某个寄存器
+	arm64 := GetGuestLayout(VexArchARM64)
+	for off := int32(0); off < arm64.StateSize; off++ {
+		_, ok := arm64.Lookup(off, 1)
+		if gap := off < 16 || off >= 852 && off < 856 || off >= 892; ok == gap {
+			t.Errorf("arm64: offset %d resolves %v", off, ok)
+		}
+	}
+	for _, c := range []struct {
+		off  int32
+		ty   IRType
+		name string
+	}{{848, ItyI32, "emnote"}, {856, ItyI64, "cmstart"}, {864, ItyI64, "cmlen"}, {872, ItyI64, "nraddr"}, {880, ItyI64, "ip_at_syscall"}} {
+		if v, ok := arm64.LookupType(c.off, c.ty); !ok || v.Name() != c.name || !v.Pseudo() {
+			t.Errorf("arm64: unexpected view %+v at %d", v, c.off)
+		}
+	}
+
+	// 提升结果中的每个 Put 都应落在某个寄存器中
+	opts := DefaultLiftOptions()
+	opts.OptLevel = 0
+	for _, c := range []struct {
+		arch VexArch
+		mc   []byte
+	}{
+		// add rax, 8; push rbx; syscall
+		{VexArchAMD64, []byte{0x48, 0x83, 0xc0, 0x08, 0x53, 0x0f, 0x05}},
+		// ic ivau, x0; svc #0
+		{VexArchARM64, []byte{0x20, 0x75, 0x0b, 0xd5, 0x01, 0x00, 0x00, 0xd4}},
+	} {
+		r, err := VexLift(c.arch, c.mc, 0x1000, VexEndnessLE, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		gl := GetGuestLayout(c.arch)
+		for i := 0; i < int(r.IRSB.StmtsUsed); i++ {
+			s := r.IRSB.GetStmt(i)
+			if s.Tag != IstPut {
+				continue
+			}
+			p := s.AsPut()
+			if _, ok := gl.Lookup(p.Offset, 1); !ok {
+				t.Errorf("%v: Put(%d) does not map to a register", c.arch, p.Offset)
+			}
+		}
+	}
+}
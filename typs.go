@@ -110,6 +110,49 @@ type IRConst struct {
 
 type IRJumpKind uint32
 
+// IRTempInvalid 表示无效的临时变量（如 Dirty 没有返回值、单元素 CAS 的 OldHi）
+const IRTempInvalid IRTemp = 0xFFFFFFFF
+
+// IREndness 表示内存访问的字节序
+type IREndness uint32
+
+const (
+	IendLE IREndness = 0x1200 // 小端
+	IendBE IREndness = 0x1201 // 大端
+)
+
+// IREffect 表示 Dirty 调用对资源的影响
+type IREffect uint32
+
+const (
+	IfxNone   IREffect = 0x1B00 // 无影响
+	IfxRead   IREffect = 0x1B01 // 读取
+	IfxWrite  IREffect = 0x1B02 // 写入
+	IfxModify IREffect = 0x1B03 // 修改（读后写）
+)
+
+// IRMBusEvent 表示内存总线事件
+type IRMBusEvent uint32
+
+const (
+	ImbeFence             IRMBusEvent = 0x1C00 // 内存屏障
+	ImbeCancelReservation IRMBusEvent = 0x1C01 // 取消 Load-Linked 的保留（仅 ARM）
+)
+
+// IRLoadGOp 表示条件加载对加载值进行的转换
+type IRLoadGOp uint32
+
+const (
+	ILGopInvalid   IRLoadGOp = 0x1D00
+	ILGopIdentV128 IRLoadGOp = 0x1D01 // 128 位向量，不转换
+	ILGopIdent64   IRLoadGOp = 0x1D02 // 64 位，不转换
+	ILGopIdent32   IRLoadGOp = 0x1D03 // 32 位，不转换
+	ILGop16Uto32   IRLoadGOp = 0x1D04 // 16 位加载，零扩展到 32 位
+	ILGop16Sto32   IRLoadGOp = 0x1D05 // 16 位加载，符号扩展到 32 位
+	ILGop8Uto32    IRLoadGOp = 0x1D06 // 8 位加载，零扩展到 32 位
+	ILGop8Sto32    IRLoadGOp = 0x1D07 // 8 位加载，符号扩展到 32 位
+)
+
 // IROp 表示VEX IR的操作码类型
 type IROp uint32
 
@@ -1264,6 +1307,13 @@ var ARM64RegisterOffsets = map[string]int{
 	// FPSR QC标志
 	"QC_FLAG": 832, "qc_flag": 832, // 0x340
 
+	// VEX 使用的伪寄存器
+	"EMNOTE": 848, "emnote": 848, // 0x350
+	"CMSTART": 856, "cmstart": 856, // 0x358
+	"CMLEN": 864, "cmlen": 864, // 0x360
+	"NRADDR": 872, "nraddr": 872, // 0x368
+	"IP_AT_SYSCALL": 880, "ip_at_syscall": 880, // 0x370
+
 	// 其他系统寄存器
 	"FPCR": 888, "fpcr": 888, // 0x378
 }
@@ -1329,34 +1379,74 @@ const (
 	VexEndnessBE      VexEndness = C.VexEndnessBE
 )
 
+// VexRegisterUpdates 控制客户机寄存器在内存访问/指令边界上的更新精度
+type VexRegisterUpdates uint32
+
+const (
+	VexRegUpdInvalid               VexRegisterUpdates = C.VexRegUpd_INVALID
+	VexRegUpdSpAtMemAccess         VexRegisterUpdates = C.VexRegUpdSpAtMemAccess         // 仅在超级块结束时更新全部寄存器，内存访问时只保证 SP
+	VexRegUpdUnwindregsAtMemAccess VexRegisterUpdates = C.VexRegUpdUnwindregsAtMemAccess // 内存访问时保证栈回溯所需寄存器（SP/IP/FP）
+	VexRegUpdAllregsAtMemAccess    VexRegisterUpdates = C.VexRegUpdAllregsAtMemAccess    // 内存访问时保证全部寄存器
+	VexRegUpdAllregsAtEachInsn     VexRegisterUpdates = C.VexRegUpdAllregsAtEachInsn     // 每条指令结束时保证全部寄存器
+	VexRegUpdLdAllregsAtEachInsn   VexRegisterUpdates = C.VexRegUpdLdAllregsAtEachInsn   // 同上，且加载时也保证
+)
+
 // NoOp 表示空操作
 type NoOp struct {
-	Dummy C.uint
+	Dummy uint32
 }
 
 // IMark 表示指令标记
 type IMark struct {
-	Addr  C.Addr  /* 指令地址 */
-	Len   C.uint  /* 指令长度 */
-	Delta C.uchar /* PC编码偏移 */
+	Addr  uint64 /* 指令地址 */
+	Len   uint32 /* 指令长度 */
+	Delta uint8  /* PC编码偏移 */
 }
 
 // AbiHint 表示 ABI 提示
 type AbiHint struct {
 	Base *IRExpr /* 未定义块的起始地址 */
-	Len  C.int   /* 未定义块的长度 */
+	Len  int32   /* 未定义块的长度 */
 	Nia  *IRExpr /* 下一条指令的地址 */
 }
 
 // Put 表示写入固定偏移的寄存器
 type Put struct {
-	Offset C.int   /* 状态偏移量 */
+	Offset int32   /* 状态偏移量 */
 	Data   *IRExpr /* 要写入的值 */
 }
 
+// IRRegArray 描述客户机状态中被当作循环数组访问的区域
+type IRRegArray struct {
+	Base   int32  /* 数组起始偏移 */
+	ElemTy IRType /* 元素类型 */
+	NElems int32  /* 元素个数 */
+}
+
+// IRCallee 描述被调用的辅助函数
+type IRCallee struct {
+	Regparms int32          /* 寄存器传参个数（x86） */
+	name     *C.char        /* 函数名，仅用于打印 */
+	Addr     unsafe.Pointer /* 宿主机上的函数地址 */
+	McxMask  uint32         /* Memcheck 忽略定义性检查的参数掩码 */
+}
+
+// Name 返回辅助函数的名称
+func (c *IRCallee) Name() string {
+	return C.GoString(c.name)
+}
+
+// IRPutI 是 PutI 的详细信息
+type IRPutI struct {
+	Descr *IRRegArray /* 作为循环数组处理的状态部分 */
+	Ix    *IRExpr     /* 数组索引的变量部分 */
+	Bias  int32       /* 数组索引的常量偏移部分 */
+	Data  *IRExpr     /* 要写入的值 */
+}
+
 // PutI 表示写入非固定偏移的寄存器
 type PutI struct {
-	Details *C.IRPutI
+	Details *IRPutI
 }
 
 // WrTmp 表示临时变量赋值
@@ -1367,68 +1457,138 @@ type WrTmp struct {
 
 // Store 表示内存存储
 type Store struct {
-	End  C.IREndness /* 字节序 */
-	Addr *IRExpr     /* 存储地址 */
-	Data *IRExpr     /* 要写入的值 */
+	End  IREndness /* 字节序 */
+	Addr *IRExpr   /* 存储地址 */
+	Data *IRExpr   /* 要写入的值 */
+}
+
+// IRStoreG 是 StoreG 的详细信息
+type IRStoreG struct {
+	End   IREndness /* 字节序 */
+	Addr  *IRExpr   /* 存储地址 */
+	Data  *IRExpr   /* 要写入的值 */
+	Guard *IRExpr   /* 条件 */
 }
 
 // StoreG 表示有条件的存储
 type StoreG struct {
-	Details *C.IRStoreG
+	Details *IRStoreG
+}
+
+// IRLoadG 是 LoadG 的详细信息
+type IRLoadG struct {
+	End   IREndness /* 字节序 */
+	Cvt   IRLoadGOp /* 对加载值进行的转换 */
+	Dst   IRTemp    /* 目标临时变量 */
+	Addr  *IRExpr   /* 加载地址 */
+	Alt   *IRExpr   /* 不加载时写入 Dst 的值 */
+	Guard *IRExpr   /* 条件 */
 }
 
 // LoadG 表示有条件的加载
 type LoadG struct {
-	Details *C.IRLoadG
+	Details *IRLoadG
+}
+
+// IRCAS 是 CAS 的详细信息，单元素 CAS 的 OldHi 为 IRTempInvalid，ExpdHi/DataHi 为 nil
+type IRCAS struct {
+	OldHi  IRTemp    /* 内存中的旧值写入此处 */
+	OldLo  IRTemp    /* */
+	End    IREndness /* 字节序 */
+	Addr   *IRExpr   /* 地址 */
+	ExpdHi *IRExpr   /* 期望的旧值 */
+	ExpdLo *IRExpr   /* */
+	DataHi *IRExpr   /* 要写入的新值 */
+	DataLo *IRExpr   /* */
 }
 
 // CAS 表示原子比较和交换操作
 type CAS struct {
-	Details *C.IRCAS
+	Details *IRCAS
 }
 
 // LLSC 表示 Load-Linked/Store-Conditional 操作
 type LLSC struct {
-	End       C.IREndness /* 字节序 */
-	Result    IRTemp      /* 结果临时变量 */
-	Addr      *IRExpr     /* 地址 */
-	StoreData *IRExpr     /* NULL表示LL，非NULL表示SC */
+	End       IREndness /* 字节序 */
+	Result    IRTemp    /* 结果临时变量 */
+	Addr      *IRExpr   /* 地址 */
+	StoreData *IRExpr   /* NULL表示LL，非NULL表示SC */
+}
+
+// VexNFxState 是 Dirty 调用最多可声明的客户机状态访问段数
+const VexNFxState = 7
+
+// IRFxState 描述 Dirty 调用对客户机状态的一段访问
+type IRFxState struct {
+	Fx        uint16 /* IREffect，在 C 中为 16 位位域，使用 Effect 读取 */
+	Offset    uint16 /* 起始偏移 */
+	Size      uint16 /* 长度 */
+	NRepeats  uint8  /* 重复次数 */
+	RepeatLen uint8  /* 每次重复的间隔 */
+}
+
+// Effect 返回该段的访问类型
+func (f *IRFxState) Effect() IREffect {
+	return IREffect(f.Fx)
+}
+
+// IRDirty 是 Dirty 的详细信息
+type IRDirty struct {
+	Cee      *IRCallee              /* 要调用的函数 */
+	Guard    *IRExpr                /* 条件，类型为 Ity_I1 */
+	Args     **IRExpr               /* 以 NULL 结尾的参数向量 */
+	Tmp      IRTemp                 /* 返回值写入的临时变量，无返回值时为 IRTempInvalid */
+	MFx      IREffect               /* 内存访问类型 */
+	MAddr    *IRExpr                /* 内存访问地址，MFx 为 IfxNone 时为 nil */
+	MSize    int32                  /* 内存访问长度 */
+	NFxState int32                  /* FxState 中有效的段数 */
+	FxState  [VexNFxState]IRFxState /* 客户机状态访问 */
+}
+
+// GetArgs 返回参数列表
+func (d *IRDirty) GetArgs() []*IRExpr {
+	return exprVec(d.Args)
+}
+
+// GetFxState 返回有效的客户机状态访问段
+func (d *IRDirty) GetFxState() []IRFxState {
+	return d.FxState[:d.NFxState]
 }
 
 // Dirty 表示调用有副作用的C函数
 type Dirty struct {
-	Details *C.IRDirty
+	Details *IRDirty
 }
 
 // MBE 表示内存总线事件
 type MBE struct {
-	Event C.IRMBusEvent
+	Event IRMBusEvent
 }
 
 // Exit 表示条件退出
 type Exit struct {
-	Guard  *IRExpr      /* 条件表达式 */
-	Dst    *IRConst     /* 跳转目标（仅常量）*/
-	Jk     C.IRJumpKind /* 跳转类型 */
-	OffsIP C.int        /* IP的状态偏移 */
+	Guard  *IRExpr    /* 条件表达式 */
+	Dst    *IRConst   /* 跳转目标（仅常量）*/
+	Jk     IRJumpKind /* 跳转类型 */
+	OffsIP int32      /* IP的状态偏移 */
 }
 
 // Binder 表示 VEX 内部的模式匹配绑定器
 type Binder struct {
-	Binder C.int
+	Binder int32
 }
 
 // Get 表示从固定偏移读取寄存器
 type Get struct {
-	Offset C.int  /* 状态偏移量 */
+	Offset int32  /* 状态偏移量 */
 	Ty     IRType /* 读取值的类型 */
 }
 
 // GetI 表示从非固定偏移读取寄存器（用于循环索引）
 type GetI struct {
-	Descr *C.IRRegArray /* 作为循环数组处理的状态部分 */
-	Ix    *IRExpr       /* 数组索引的变量部分 */
-	Bias  C.int         /* 数组索引的常量偏移部分 */
+	Descr *IRRegArray /* 作为循环数组处理的状态部分 */
+	Ix    *IRExpr     /* 数组索引的变量部分 */
+	Bias  int32       /* 数组索引的常量偏移部分 */
 }
 
 // RdTmp 表示读取临时变量
@@ -1436,9 +1596,17 @@ type RdTmp struct {
 	Tmp IRTemp /* 临时变量编号 */
 }
 
+type IRQop struct {
+	Op   IROp
+	Arg1 *IRExpr
+	Arg2 *IRExpr
+	Arg3 *IRExpr
+	Arg4 *IRExpr
+}
+
 // Qop 表示四元操作
 type Qop struct {
-	Details *C.IRQop
+	Details *IRQop
 }
 
 type IRTriop struct {
@@ -1468,9 +1636,9 @@ type Unop struct {
 
 // Load 表示从内存加载（普通加载，非 Load-Linked）
 type Load struct {
-	End  C.IREndness /* 字节序 */
-	Ty   IRType      /* 加载值的类型 */
-	Addr *IRExpr     /* 加载地址 */
+	End  IREndness /* 字节序 */
+	Ty   IRType    /* 加载值的类型 */
+	Addr *IRExpr   /* 加载地址 */
 }
 
 // Const 表示常量表达式
@@ -1480,9 +1648,26 @@ type Const struct {
 
 // CCall 表示调用纯C函数（无副作用）
 type CCall struct {
-	Cee   *C.IRCallee /* 要调用的函数 */
-	RetTy IRType      /* 返回值类型 */
-	Args  **IRExpr    /* 参数表达式向量 */
+	Cee   *IRCallee /* 要调用的函数 */
+	RetTy IRType    /* 返回值类型 */
+	Args  **IRExpr  /* 参数表达式向量 */
+}
+
+// GetArgs 返回参数列表
+func (c *CCall) GetArgs() []*IRExpr {
+	return exprVec(c.Args)
+}
+
+// exprVec 将以 NULL 结尾的表达式数组转换为切片
+func exprVec(args **IRExpr) []*IRExpr {
+	var res []*IRExpr
+	if args == nil {
+		return res
+	}
+	for p := unsafe.Pointer(args); *(**IRExpr)(p) != nil; p = unsafe.Add(p, unsafe.Sizeof(uintptr(0))) {
+		res = append(res, *(**IRExpr)(p))
+	}
+	return res
 }
 
 // ITE 表示三元 if-then-else 操作（严格求值）
@@ -1686,8 +1871,8 @@ func (i *IRExpr) AsITE() *ITE {
 
 type IRTypeEnv struct {
 	Types     unsafe.Pointer // 指向IRType数组的指针
-	TypesSize C.Int          // 数组的大小
-	TypesUsed C.Int          // 实际使用的类型数量
+	TypesSize int32          // 数组的大小
+	TypesUsed int32          // 实际使用的类型数量
 }
 
 // GetType 根据索引获取IRType
@@ -1703,19 +1888,19 @@ func (e *IRTypeEnv) GetType(idx int) IRType {
 
 type IRSb struct {
 	TyEnv     *IRTypeEnv
-	Stmts     **C.IRStmt
-	StmtsSize C.Int
-	StmtsUsed C.Int
+	Stmts     **IRStmt
+	StmtsSize int32
+	StmtsUsed int32
 	Next      *IRExpr
 	JumpKind  IRJumpKind
-	OffsIP    C.Int
+	OffsIP    int32
 }
 
 func (isb *IRSb) GetStmt(index int) *IRStmt {
 	if index < 0 || index >= int(isb.StmtsUsed) {
 		return nil
 	}
-	return (*IRStmt)(unsafe.Pointer(*(**C.IRStmt)(unsafe.Pointer(uintptr(unsafe.Pointer(isb.Stmts)) + uintptr(index)*unsafe.Sizeof(uintptr(0))))))
+	return *(**IRStmt)(unsafe.Add(unsafe.Pointer(isb.Stmts), uintptr(index)*unsafe.Sizeof(uintptr(0))))
 }
 
 func GetIRTypeSize(irt IRType) int {
@@ -1779,3 +1964,29 @@ func GetIRConstTagSize(ict IRConstTag) int {
 		panic("unknown IRConstTag size")
 	}
 }
+
+// 编译期检查 Go 镜像结构体与 C 结构体的布局一致，不一致时常量下溢导致编译失败
+const (
+	_ = uintptr(C.sizeof_IRRegArray) - unsafe.Sizeof(IRRegArray{})
+	_ = unsafe.Sizeof(IRRegArray{}) - uintptr(C.sizeof_IRRegArray)
+	_ = uintptr(C.sizeof_IRCallee) - unsafe.Sizeof(IRCallee{})
+	_ = unsafe.Sizeof(IRCallee{}) - uintptr(C.sizeof_IRCallee)
+	_ = uintptr(C.sizeof_IRPutI) - unsafe.Sizeof(IRPutI{})
+	_ = unsafe.Sizeof(IRPutI{}) - uintptr(C.sizeof_IRPutI)
+	_ = uintptr(C.sizeof_IRStoreG) - unsafe.Sizeof(IRStoreG{})
+	_ = unsafe.Sizeof(IRStoreG{}) - uintptr(C.sizeof_IRStoreG)
+	_ = uintptr(C.sizeof_IRLoadG) - unsafe.Sizeof(IRLoadG{})
+	_ = unsafe.Sizeof(IRLoadG{}) - uintptr(C.sizeof_IRLoadG)
+	_ = uintptr(C.sizeof_IRCAS) - unsafe.Sizeof(IRCAS{})
+	_ = unsafe.Sizeof(IRCAS{}) - uintptr(C.sizeof_IRCAS)
+	_ = uintptr(C.sizeof_IRQop) - unsafe.Sizeof(IRQop{})
+	_ = unsafe.Sizeof(IRQop{}) - uintptr(C.sizeof_IRQop)
+	_ = uintptr(C.sizeof_IRTriop) - unsafe.Sizeof(IRTriop{})
+	_ = unsafe.Sizeof(IRTriop{}) - uintptr(C.sizeof_IRTriop)
+	_ = uintptr(C.sizeof_IRDirty) - unsafe.Sizeof(IRDirty{})
+	_ = unsafe.Sizeof(IRDirty{}) - uintptr(C.sizeof_IRDirty)
+	_ = uintptr(C.sizeof_IRTypeEnv) - unsafe.Sizeof(IRTypeEnv{})
+	_ = unsafe.Sizeof(IRTypeEnv{}) - uintptr(C.sizeof_IRTypeEnv)
+	_ = uintptr(C.sizeof_IRSB) - unsafe.Sizeof(IRSb{})
+	_ = unsafe.Sizeof(IRSb{}) - uintptr(C.sizeof_IRSB)
+)
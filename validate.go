@@ -0,0 +1,391 @@
+package vex_go
+
+import "fmt"
+
+// 本文件在 Go 侧实现与 VEX sanityCheckIRSB 类似的检查，用于发现构造或变换后的块中的类型错误
+
+// Diagnostic 是 Validate 发现的一个问题
+type Diagnostic struct {
+	Stmt int    // 语句下标，块出口（Next/JumpKind）为 -1
+	Msg  string // 问题描述
+}
+
+func (d Diagnostic) String() string {
+	if d.Stmt < 0 {
+		return "next: " + d.Msg
+	}
+	return fmt.Sprintf("stmt %d: %s", d.Stmt, d.Msg)
+}
+
+// validator 保存检查过程中的状态
+type validator struct {
+	b       *Block
+	word    IRType // 架构字长对应的类型，架构未知时为 ItyINVALID
+	state   int32  // 客户机状态大小，未知时为 0
+	written []bool // 临时变量是否已赋值
+	stmt    int
+	diags   []Diagnostic
+}
+
+// Validate 检查块是否满足 VEX 对扁平 IR 的要求：临时变量只赋值一次且先赋值后使用，
+// 操作数类型与 IROp 签名一致，条件为 I1，地址为架构字长，Get/Put 不越出客户机状态等。
+// 块合法时返回 nil。架构未知时跳过与架构有关的检查
+func Validate(b *Block) []Diagnostic {
+	v := &validator{b: b, word: ItyINVALID, written: make([]bool, len(b.TypeEnv))}
+	if d := GetArchDescriptor(b.Arch); d != nil {
+		v.word = ItyI64
+		if d.WordSize == 4 {
+			v.word = ItyI32
+		}
+	}
+	if l := GetGuestLayout(b.Arch); l != nil {
+		v.state = l.StateSize
+	}
+	for t, ty := range b.TypeEnv {
+		if _, ok := irTypeNames[ty]; !ok || ty == ItyINVALID {
+			v.stmt = -1
+			v.errorf("%v has invalid type %v", IRTemp(t), ty)
+		}
+	}
+	for i, s := range b.Stmts {
+		v.stmt = i
+		v.checkStmt(s)
+	}
+	v.stmt = -1
+	if b.Next == nil {
+		v.errorf("missing next expression")
+	} else {
+		v.checkWord("next", b.Next)
+	}
+	if _, ok := irJumpKindNames[b.JumpKind]; !ok || b.JumpKind == IjkInvalid {
+		v.errorf("invalid jump kind %v", b.JumpKind)
+	}
+	v.checkGuest("IP", b.OffsIP, v.word)
+	return v.diags
+}
+
+func (v *validator) errorf(format string, args ...any) {
+	v.diags = append(v.diags, Diagnostic{Stmt: v.stmt, Msg: fmt.Sprintf(format, args...)})
+}
+
+// expr 检查表达式并返回其类型，无法确定类型时返回 ItyINVALID
+func (v *validator) expr(e Expr) IRType {
+	switch e := e.(type) {
+	case nil:
+		v.errorf("nil expression")
+	case *BinderExpr:
+		v.errorf("binder %v in flat IR", e)
+	case *GetExpr:
+		v.notI1("GET", e.Ty)
+		v.checkGuest("GET", e.Offset, e.Ty)
+		return e.Ty
+	case *GetIExpr:
+		v.checkRegArray(e.Descr)
+		v.check("GETI index", e.Ix, ItyI32)
+		return e.Descr.ElemTy
+	case *RdTmpExpr:
+		return v.read(e.Tmp)
+	case *QopExpr:
+		return v.op(e.Op, e.Arg1, e.Arg2, e.Arg3, e.Arg4)
+	case *TriopExpr:
+		return v.op(e.Op, e.Arg1, e.Arg2, e.Arg3)
+	case *BinopExpr:
+		return v.op(e.Op, e.Arg1, e.Arg2)
+	case *UnopExpr:
+		return v.op(e.Op, e.Arg)
+	case *LoadExpr:
+		v.checkEndness(e.End)
+		v.notI1("load", e.Ty)
+		v.checkWord("load address", e.Addr)
+		return e.Ty
+	case *ConstExpr:
+		if _, ok := irConstTagNames[e.Con.Tag]; !ok {
+			v.errorf("invalid constant tag %v", e.Con.Tag)
+			return ItyINVALID
+		}
+		return e.Con.Type()
+	case *CCallExpr:
+		v.notI1("CCall return", e.RetTy)
+		v.args(e.Cee.Name, e.Args, false)
+		return e.RetTy
+	case *ITEExpr:
+		v.check("ITE condition", e.Cond, ItyI1)
+		t := v.expr(e.IfTrue)
+		if f := v.expr(e.IfFalse); t != ItyINVALID && f != ItyINVALID && t != f {
+			v.errorf("ITE branches differ: %s vs %s", ppType(t), ppType(f))
+		}
+		return t
+	case *GSPTRExpr, *VECRETExpr:
+		v.errorf("%v outside dirty call arguments", e)
+	default:
+		v.errorf("unknown expression %T", e)
+	}
+	return ItyINVALID
+}
+
+// op 检查操作码的参数个数与类型
+func (v *validator) op(op IROp, args ...Expr) IRType {
+	res := opResultType(op)
+	if res == ItyINVALID || op == IopINVALID {
+		v.errorf("unknown IROp %v", op)
+		for _, a := range args {
+			v.expr(a)
+		}
+		return ItyINVALID
+	}
+	if n := op.Arity(); n != len(args) {
+		v.errorf("%v takes %d args, got %d", op, n, len(args))
+		return res
+	}
+	for i, want := range op.ArgTypes() {
+		v.check(fmt.Sprintf("%v arg %d", op, i+1), args[i], want)
+	}
+	return res
+}
+
+// args 检查调用参数，dirty 为 true 时允许 GSPTR 与 VECRET
+func (v *validator) args(callee string, args []Expr, dirty bool) {
+	for i, a := range args {
+		switch a.(type) {
+		case *GSPTRExpr, *VECRETExpr:
+			if !dirty {
+				v.errorf("%s arg %d: %v outside dirty call arguments", callee, i+1, a)
+			}
+			continue
+		}
+		if ty := v.expr(a); ty == ItyI1 {
+			v.errorf("%s arg %d has type I1", callee, i+1)
+		}
+	}
+}
+
+// check 检查 e 的类型为 want
+func (v *validator) check(what string, e Expr, want IRType) {
+	if ty := v.expr(e); ty != ItyINVALID && ty != want {
+		v.errorf("%s %v has type %s, want %s", what, e, ppType(ty), ppType(want))
+	}
+}
+
+// checkWord 检查 e 为架构字长，架构未知时只检查表达式本身
+func (v *validator) checkWord(what string, e Expr) {
+	if v.word == ItyINVALID {
+		v.expr(e)
+		return
+	}
+	v.check(what, e, v.word)
+}
+
+func (v *validator) notI1(what string, ty IRType) {
+	if ty == ItyI1 {
+		v.errorf("%s of type I1", what)
+	} else if _, ok := irTypeNames[ty]; !ok || ty == ItyINVALID {
+		v.errorf("%s of invalid type %v", what, ty)
+	}
+}
+
+func (v *validator) checkEndness(end IREndness) {
+	if end != IendLE && end != IendBE {
+		v.errorf("invalid endness %v", end)
+	}
+}
+
+// checkGuest 检查对客户机状态 [offset, offset+size(ty)) 的访问不越界
+func (v *validator) checkGuest(what string, offset int32, ty IRType) {
+	if v.state == 0 || ty == ItyINVALID {
+		return
+	}
+	if _, ok := irTypeNames[ty]; !ok {
+		return
+	}
+	if size := int32(GetIRTypeSize(ty)); offset < 0 || offset+size > v.state {
+		v.errorf("%s offset %d size %d outside guest state of %d bytes", what, offset, size, v.state)
+	}
+}
+
+func (v *validator) checkRegArray(r RegArray) {
+	v.notI1("register array element", r.ElemTy)
+	if r.NElems <= 0 || r.NElems > 500 {
+		v.errorf("implausible register array length %d", r.NElems)
+		return
+	}
+	if _, ok := irTypeNames[r.ElemTy]; !ok || r.ElemTy == ItyINVALID || v.state == 0 {
+		return
+	}
+	if end := r.Base + r.NElems*int32(GetIRTypeSize(r.ElemTy)); r.Base < 0 || end > v.state {
+		v.errorf("register array %v outside guest state of %d bytes", r, v.state)
+	}
+}
+
+// read 检查临时变量已赋值并返回其类型
+func (v *validator) read(t IRTemp) IRType {
+	if int(t) >= len(v.b.TypeEnv) {
+		v.errorf("%v not in type environment", t)
+		return ItyINVALID
+	}
+	if !v.written[t] {
+		v.errorf("%v used before assignment", t)
+	}
+	return v.b.TypeEnv[t]
+}
+
+// write 记录对临时变量的赋值并检查其类型，want 为 ItyINVALID 时不检查类型
+func (v *validator) write(t IRTemp, want IRType) {
+	if int(t) >= len(v.b.TypeEnv) {
+		v.errorf("%v not in type environment", t)
+		return
+	}
+	if v.written[t] {
+		v.errorf("%v assigned more than once", t)
+	}
+	v.written[t] = true
+	if ty := v.b.TypeEnv[t]; want != ItyINVALID && ty != want {
+		v.errorf("%v has type %s, assigned %s", t, ppType(ty), ppType(want))
+	}
+}
+
+func (v *validator) checkStmt(s Stmt) {
+	switch s := s.(type) {
+	case nil:
+		v.errorf("nil statement")
+	case *NoOpStmt:
+	case *IMarkStmt:
+		if s.Len > 20 {
+			v.errorf("implausible IMark length %d", s.Len)
+		}
+		if s.Delta > 1 {
+			v.errorf("implausible IMark delta %d", s.Delta)
+		}
+	case *AbiHintStmt:
+		v.checkWord("AbiHint base", s.Base)
+		v.checkWord("AbiHint nia", s.Nia)
+	case *PutStmt:
+		ty := v.expr(s.Data)
+		if ty == ItyI1 {
+			v.errorf("PUT of type I1")
+		}
+		v.checkGuest("PUT", s.Offset, ty)
+	case *PutIStmt:
+		v.checkRegArray(s.Descr)
+		v.check("PUTI index", s.Ix, ItyI32)
+		v.check("PUTI data", s.Data, s.Descr.ElemTy)
+	case *WrTmpStmt:
+		v.write(s.Tmp, v.expr(s.Data))
+	case *StoreStmt:
+		v.checkEndness(s.End)
+		v.checkWord("store address", s.Addr)
+		if v.expr(s.Data) == ItyI1 {
+			v.errorf("store of type I1")
+		}
+	case *StoreGStmt:
+		v.checkEndness(s.End)
+		v.check("StoreG guard", s.Guard, ItyI1)
+		v.checkWord("StoreG address", s.Addr)
+		if v.expr(s.Data) == ItyI1 {
+			v.errorf("StoreG of type I1")
+		}
+	case *LoadGStmt:
+		v.checkEndness(s.End)
+		v.check("LoadG guard", s.Guard, ItyI1)
+		v.checkWord("LoadG address", s.Addr)
+		res, _ := typeOfLoadGOp(s.Cvt)
+		if res == ItyINVALID {
+			v.errorf("invalid LoadG conversion %v", s.Cvt)
+		} else {
+			v.check("LoadG alternative", s.Alt, res)
+		}
+		v.write(s.Dst, res)
+	case *CASStmt:
+		v.checkCAS(s)
+	case *LLSCStmt:
+		v.checkEndness(s.End)
+		v.checkWord("LLSC address", s.Addr)
+		if s.StoreData == nil {
+			v.write(s.Result, ItyINVALID)
+			if ty := v.b.TypeOfTemp(s.Result); ty != ItyI8 && ty != ItyI16 && ty != ItyI32 && ty != ItyI64 {
+				v.errorf("load-linked result %v has type %s", s.Result, ppType(ty))
+			}
+		} else {
+			if ty := v.expr(s.StoreData); ty != ItyI8 && ty != ItyI16 && ty != ItyI32 && ty != ItyI64 {
+				v.errorf("store-conditional data has type %s", ppType(ty))
+			}
+			v.write(s.Result, ItyI1)
+		}
+	case *DirtyStmt:
+		v.checkDirty(s)
+	case *MBEStmt:
+		if _, ok := irMBusEventNames[s.Event]; !ok {
+			v.errorf("invalid memory bus event %v", s.Event)
+		}
+	case *ExitStmt:
+		v.check("exit guard", s.Guard, ItyI1)
+		if v.word != ItyINVALID && s.Dst.Type() != v.word {
+			v.errorf("exit target %v is not %s", s.Dst, ppType(v.word))
+		}
+		if _, ok := irJumpKindNames[s.Jk]; !ok || s.Jk == IjkInvalid {
+			v.errorf("invalid jump kind %v", s.Jk)
+		}
+		if s.OffsIP != v.b.OffsIP {
+			v.errorf("exit writes IP at %d, block uses %d", s.OffsIP, v.b.OffsIP)
+		}
+	default:
+		v.errorf("unknown statement %T", s)
+	}
+}
+
+func (v *validator) checkCAS(s *CASStmt) {
+	v.checkEndness(s.End)
+	v.checkWord("CAS address", s.Addr)
+	ty := v.expr(s.ExpdLo)
+	v.check("CAS dataLo", s.DataLo, ty)
+	switch ty {
+	case ItyI8, ItyI16, ItyI32, ItyI64, ItyV128, ItyINVALID:
+	default:
+		v.errorf("CAS of type %s", ppType(ty))
+	}
+	double := s.OldHi != IRTempInvalid
+	if double != (s.ExpdHi != nil) || double != (s.DataHi != nil) {
+		v.errorf("CAS mixes single and double element forms")
+	}
+	if double {
+		v.check("CAS expdHi", s.ExpdHi, ty)
+		v.check("CAS dataHi", s.DataHi, ty)
+		v.write(s.OldHi, ty)
+		if s.OldHi == s.OldLo {
+			v.errorf("CAS writes %v twice", s.OldLo)
+			return
+		}
+	}
+	v.write(s.OldLo, ty)
+}
+
+func (v *validator) checkDirty(s *DirtyStmt) {
+	v.check("dirty guard", s.Guard, ItyI1)
+	v.args(s.Cee.Name, s.Args, true)
+	if (s.MFx == IfxNone) != (s.MAddr == nil) || s.MFx == IfxNone && s.MSize != 0 {
+		v.errorf("inconsistent memory effect %s (size %d)", ppEffect(s.MFx), s.MSize)
+	}
+	if s.MAddr != nil {
+		v.checkWord("dirty memory address", s.MAddr)
+		if s.MSize <= 0 {
+			v.errorf("dirty memory effect of size %d", s.MSize)
+		}
+	}
+	for _, fx := range s.FxState {
+		if fx.Fx == IfxNone {
+			v.errorf("guest state effect with %s", ppEffect(fx.Fx))
+		}
+		end := int32(fx.Offset) + int32(fx.Size)
+		if fx.NRepeats > 0 {
+			end += int32(fx.NRepeats) * int32(fx.RepeatLen)
+		}
+		if v.state != 0 && end > v.state {
+			v.errorf("guest state effect at %d outside guest state of %d bytes", fx.Offset, v.state)
+		}
+	}
+	if s.Tmp != IRTempInvalid {
+		v.write(s.Tmp, ItyINVALID)
+		if ty := v.b.TypeOfTemp(s.Tmp); ty == ItyI1 {
+			v.errorf("dirty call result %v of type I1", s.Tmp)
+		}
+	}
+}
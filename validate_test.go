@@ -0,0 +1,62 @@
+package vex_go
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestValidateLifted(t *testing.T) {
+	blocks := liftTestBlocks(t)
+	// 默认优化级别下的块同样应通过检查
+	for _, mc := range [][]byte{
+		{0x48, 0x8b, 0x47, 0x08, 0x48, 0x01, 0xf0, 0x48, 0x85, 0xc0, 0x74, 0xf4, 0xc3},
+		{0xd9, 0xc1, 0xde, 0xc1, 0xe8, 0x00, 0x00, 0x00, 0x00},
+	} {
+		r, err := NewLifter(VexArchAMD64, VexEndnessLE, nil).Lift(mc, 0x400000)
+		if err != nil {
+			t.Fatal(err)
+		}
+		blocks = append(blocks, r.Block)
+	}
+	for _, b := range blocks {
+		if diags := Validate(b); diags != nil {
+			t.Errorf("%v: unexpected diagnostics %v\n%s", b.Arch, diags, b)
+		}
+	}
+}
+
+func TestValidateDiagnostics(t *testing.T) {
+	b, err := ParseIRSB(VexArchAMD64, `
+   t0:I64   t1:I32   t2:I1
+   t0 = GET:I64(16)
+   t1 = Add32(t0,0x1:I32)
+   t0 = GET:I64(24)
+   PUT(4096) = t0
+   STle(t1) = t0
+   if (t0) { PUT(184) = 0x1000:I64; exit-Boring }
+   PUT(16) = t3
+   NEXT: PUT(rip) = t2; Ijk_Boring
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	size := GetGuestLayout(VexArchAMD64).StateSize
+	want := []string{
+		"stmt 1: Add32 arg 1 t0 has type I64, want I32",
+		"stmt 2: t0 assigned more than once",
+		fmt.Sprintf("stmt 3: PUT offset 4096 size 8 outside guest state of %d bytes", size),
+		"stmt 4: store address t1 has type I32, want I64",
+		"stmt 5: exit guard t0 has type I64, want I1",
+		"stmt 6: t3 not in type environment",
+		"next: t2 used before assignment",
+		"next: next t2 has type I1, want I64",
+	}
+	var got []string
+	for _, d := range Validate(b) {
+		got = append(got, d.String())
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got  %q\nwant %q", got, want)
+	}
+}
@@ -12,30 +12,349 @@ package vex_go
 #include <libvex.h>
 #include "libvex.h"
 #include "libvex_ir.h"
+#include "libvex_guest_x86.h"
+#include "libvex_guest_amd64.h"
+#include "libvex_guest_arm.h"
+#include "libvex_guest_arm64.h"
+#include "libvex_guest_ppc32.h"
+#include "libvex_guest_ppc64.h"
+#include "libvex_guest_s390x.h"
+#include "libvex_guest_mips32.h"
+#include "libvex_guest_mips64.h"
+#include "libvex_guest_riscv64.h"
 #include "pyvex.h"
 */
 import "C"
 import (
+	"fmt"
+	"sync"
 	"unsafe"
 )
 
-func VexInit() bool {
-	r := C.vex_init()
-	if r == 1 {
-		return true
+// guestStateSizes 为各架构 VexGuestXXXState 的字节数
+var guestStateSizes = map[VexArch]int32{
+	VexArchX86:     C.sizeof_VexGuestX86State,
+	VexArchAMD64:   C.sizeof_VexGuestAMD64State,
+	VexArchARM:     C.sizeof_VexGuestARMState,
+	VexArchARM64:   C.sizeof_VexGuestARM64State,
+	VexArchPPC32:   C.sizeof_VexGuestPPC32State,
+	VexArchPPC64:   C.sizeof_VexGuestPPC64State,
+	VexArchS390X:   C.sizeof_VexGuestS390XState,
+	VexArchMIPS32:  C.sizeof_VexGuestMIPS32State,
+	VexArchMIPS64:  C.sizeof_VexGuestMIPS64State,
+	VexArchRISCV64: C.sizeof_VexGuestRISCV64State,
+}
+
+// liftMu 串行化所有对 VEX 的调用。pyvex 与 VEX 使用全局的翻译参数、
+// 结果缓冲区、jmp_buf 以及临时内存池，无法在多个线程中同时提升
+var liftMu sync.Mutex
+
+// VexInit 初始化 VEX，可重复调用。提升前会自动初始化，无需显式调用
+func VexInit() error {
+	liftMu.Lock()
+	defer liftMu.Unlock()
+	return vexInit()
+}
+
+// vexInit 初始化 VEX，调用方必须持有 liftMu
+func vexInit() error {
+	if C.vex_init() == 1 {
+		return nil
+	}
+	return fmt.Errorf("%w: %s", ErrInit, vexLog())
+}
+
+// vexLog 返回 VEX 自上次提升开始以来输出的日志，调用方必须持有 liftMu
+func vexLog() string {
+	if C.msg_buffer == nil {
+		return ""
+	}
+	return C.GoStringN(C.msg_buffer, C.int(C.msg_current_size))
+}
+
+// vexPrintStmt 返回 VEX ppIRStmt 的输出，调用方必须持有 liftMu
+func vexPrintStmt(s *IRStmt) string {
+	C.clear_log()
+	C.ppIRStmt((*C.IRStmt)(unsafe.Pointer(s)))
+	return vexLog()
+}
+
+// vexPrintExpr 返回 VEX ppIRExpr 的输出，调用方必须持有 liftMu
+func vexPrintExpr(e *IRExpr) string {
+	C.clear_log()
+	C.ppIRExpr((*C.IRExpr)(unsafe.Pointer(e)))
+	return vexLog()
+}
+
+// vexPrintIRSB 返回 VEX ppIRSB 的输出，调用方必须持有 liftMu
+func vexPrintIRSB(isb *IRSb) string {
+	C.clear_log()
+	C.ppIRSB((*C.IRSB)(unsafe.Pointer(isb)))
+	return vexLog()
+}
+
+// LiftOptions 控制一次提升的各项参数，对应 vex_lift 的各个入参。
+// 超出范围的参数在调用 VEX 之前以 ErrInvalidOptions 拒绝，零值的 LiftOptions 不是合法的参数
+type LiftOptions struct {
+	MaxInsns               uint32             // 单个块最多提升的指令数，1 到 MaxLiftInsns
+	MaxBytes               uint32             // 单个块最多提升的字节数，1 到 MaxLiftBytes，同时受输入长度限制
+	OptLevel               int                // IR 优化级别：0 不优化，1 简单优化，2 完全优化
+	TraceFlags             int                // VEX 调试输出标志，0 表示关闭
+	AllowArchOptimizations bool               // 允许架构相关的优化（ARM 回看、ARM64 写回重排、x86 call/pop 合并）
+	StrictBlockEnd         bool               // 严格的块结束判定（如 ARM Thumb 的 CB{N}Z 视为跳转）
+	CollectDataRefs        bool               // 收集数据引用
+	LoadFromRORegions      bool               // 允许从已注册的只读区域读取常量
+	ConstProp              bool               // 进行常量传播并记录结果
+	RegisterUpdates        VexRegisterUpdates // 寄存器更新精度，VexRegUpdSpAtMemAccess 到 VexRegUpdLdAllregsAtEachInsn
+	LookbackAmount         uint32             // 允许在指令起始地址之前读取的字节数，这些字节位于 mc 开头，提升从 mc[LookbackAmount] 开始，必须小于 len(mc)
+	ArchInfo               *ArchInfo          // 处理器的 hwcaps 等参数，nil 时使用 DefaultArchInfo
+}
+
+const (
+	// MaxLiftInsns 是 VEX 单个块允许的最大指令数
+	MaxLiftInsns = 99
+	// MaxLiftBytes 是 VEX 单个块允许的最大字节数
+	MaxLiftBytes = 5000
+)
+
+// DefaultLiftOptions 返回默认的提升参数
+func DefaultLiftOptions() *LiftOptions {
+	return &LiftOptions{
+		MaxInsns:          MaxLiftInsns,
+		MaxBytes:          MaxLiftBytes,
+		OptLevel:          1,
+		StrictBlockEnd:    true,
+		LoadFromRORegions: true,
+		RegisterUpdates:   VexRegUpdUnwindregsAtMemAccess,
+	}
+}
+
+// check 检查各参数是否在 VEX 接受的范围内，n 为输入的字节数
+func (o *LiftOptions) check(n int) error {
+	switch {
+	case o.MaxInsns < 1 || o.MaxInsns > MaxLiftInsns:
+		return fmt.Errorf("%w: MaxInsns %d not in [1, %d]", ErrInvalidOptions, o.MaxInsns, MaxLiftInsns)
+	case o.MaxBytes < 1 || o.MaxBytes > MaxLiftBytes:
+		return fmt.Errorf("%w: MaxBytes %d not in [1, %d]", ErrInvalidOptions, o.MaxBytes, MaxLiftBytes)
+	case o.OptLevel < 0 || o.OptLevel > 2:
+		return fmt.Errorf("%w: OptLevel %d not in [0, 2]", ErrInvalidOptions, o.OptLevel)
+	case o.RegisterUpdates < VexRegUpdSpAtMemAccess || o.RegisterUpdates > VexRegUpdLdAllregsAtEachInsn:
+		return fmt.Errorf("%w: RegisterUpdates %v", ErrInvalidOptions, o.RegisterUpdates)
+	case int(o.LookbackAmount) >= n:
+		return fmt.Errorf("%w: LookbackAmount %d leaves no input of %d bytes", ErrInvalidOptions, o.LookbackAmount, n)
+	}
+	return nil
+}
+
+func cBool(b bool) C.int {
+	if b {
+		return 1
+	}
+	return 0
+}
+
+// DataRefType 表示数据引用的类型
+type DataRefType uint32
+
+const (
+	DtUnknown      DataRefType = C.Dt_Unknown
+	DtInteger      DataRefType = C.Dt_Integer      // 整数读取
+	DtFP           DataRefType = C.Dt_FP           // 浮点读取
+	DtStoreInteger DataRefType = C.Dt_StoreInteger // 整数写入
+)
+
+// ExitInfo 描述块内的一个条件退出
+type ExitInfo struct {
+	StmtIdx int     // Exit 语句在块中的下标
+	InsAddr uint64  // 所属指令的地址
+	Stmt    *IRStmt // Exit 语句本身，与 LiftResult.IRSB 有相同的生命周期
+}
+
+// DataRef 描述一次数据引用
+type DataRef struct {
+	DataAddr uint64
+	Size     int
+	DataType DataRefType
+	StmtIdx  int
+	InsAddr  uint64
+}
+
+// ConstVal 描述常量传播得到的临时变量值
+type ConstVal struct {
+	Tmp     IRTemp
+	StmtIdx int
+	Value   uint64
+}
+
+// LiftResult 是 VEXLiftResult 的 Go 副本，除 IRSB 外的字段均位于 Go 内存中
+type LiftResult struct {
+	IRSB                  *IRSb  // 指向 VEX 内存池，下一次提升后失效
+	Block                 *Block // IRSB 的 Go 副本，仅由 Lifter.Lift 填写
+	Size                  int    // 块的字节数
+	IsNoopBlock           bool   // 块内是否只有空指令
+	Exits                 []ExitInfo
+	IsDefaultExitConstant bool   // 默认出口是否为常量
+	DefaultExit           uint64 // 默认出口地址，仅当 IsDefaultExitConstant 为 true 时有效
+	InstAddrs             []uint64
+	DataRefs              []DataRef  // 需开启 LiftOptions.CollectDataRefs
+	ConstVals             []ConstVal // 需开启 LiftOptions.ConstProp
+	Truncated             bool       // 块在无法解码或超出输入末尾的指令前被截断，仅由 LiftBlock 填写
+}
+
+// Insts 返回块内的指令数
+func (r *LiftResult) Insts() int {
+	return len(r.InstAddrs)
+}
+
+func newLiftResult(r *C.VEXLiftResult) *LiftResult {
+	res := &LiftResult{
+		IRSB:                  (*IRSb)(unsafe.Pointer(r.irsb)),
+		Size:                  int(r.size),
+		IsNoopBlock:           r.is_noop_block != 0,
+		IsDefaultExitConstant: r.is_default_exit_constant != 0,
+		DefaultExit:           uint64(r.default_exit),
 	}
-	return false
+	res.Exits = make([]ExitInfo, int(r.exit_count))
+	for i := range res.Exits {
+		e := &r.exits[i]
+		res.Exits[i] = ExitInfo{
+			StmtIdx: int(e.stmt_idx),
+			InsAddr: uint64(e.ins_addr),
+			Stmt:    (*IRStmt)(unsafe.Pointer(e.stmt)),
+		}
+	}
+	res.InstAddrs = make([]uint64, int(r.insts))
+	for i := range res.InstAddrs {
+		res.InstAddrs[i] = uint64(r.inst_addrs[i])
+	}
+	res.DataRefs = make([]DataRef, int(r.data_ref_count))
+	for i := range res.DataRefs {
+		d := &r.data_refs[i]
+		res.DataRefs[i] = DataRef{
+			DataAddr: uint64(d.data_addr),
+			Size:     int(d.size),
+			DataType: DataRefType(d.data_type),
+			StmtIdx:  int(d.stmt_idx),
+			InsAddr:  uint64(d.ins_addr),
+		}
+	}
+	res.ConstVals = make([]ConstVal, int(r.const_val_count))
+	for i := range res.ConstVals {
+		c := &r.const_vals[i]
+		res.ConstVals[i] = ConstVal{
+			Tmp:     IRTemp(c.tmp),
+			StmtIdx: int(c.stmt_idx),
+			Value:   uint64(c.value),
+		}
+	}
+	return res
 }
 
-func VexLift(v VexArch, mc []byte, insAddr uint64, en VexEndness) *IRSb {
-	var vai C.VexArchInfo
-	vai.endness = C.VexEndness(en)
+// VexLift 提升 mc 中从 insAddr 开始的机器码，opts 为 nil 时使用 DefaultLiftOptions。
+// 返回的 IRSB 位于 VEX 的临时内存池中，任意 goroutine 的下一次提升都会使其失效，
+// 并发场景请使用 Lifter。失败时返回的错误为 *LiftError
+func VexLift(v VexArch, mc []byte, insAddr uint64, en VexEndness, opts *LiftOptions) (*LiftResult, error) {
+	liftMu.Lock()
+	defer liftMu.Unlock()
+	return vexLift(v, mc, insAddr, en, opts)
+}
 
-	cData := (*C.uchar)(unsafe.Pointer(&mc[0]))
-	r := C.vex_lift(C.VexArch(v), vai, cData, C.ulonglong(insAddr), C.uint(99), C.uint(4),
-		C.int(1), C.int(0), C.int(0), C.int(1), C.int(0), C.int(1), C.int(0), C.VexRegUpdUnwindregsAtMemAccess, C.uint(0))
+// liftPadding 是输入缓冲区末尾追加的填充字节数。VEX 先完整解码一条指令再检查
+// guest_max_bytes，解码器可能越过输入末尾读取（x86 前缀、s390x 6 字节指令等），
+// 因此输入会先复制到带填充的 C 缓冲区中，填充默认为零
+const liftPadding = 64
+
+// x86MaxInsnLen 是 x86/AMD64 指令的最大长度
+const x86MaxInsnLen = 15
+
+// x86ProbePadding 是检测 x86/AMD64 截断指令时换用的填充字节：0xc0 作为 ModRM 选择寄存器操作数，
+// 0xe1 与 0x01 作为 VEX 前缀的后续字节时选择 0F 与 0F38 映射中存在的指令
+var x86ProbePadding = []byte{0xc0, 0xe1, 0x01}
+
+// liftBuf 是复用的输入缓冲区，由 liftMu 保护
+var (
+	liftBuf    unsafe.Pointer
+	liftBufCap int
+)
+
+// prepareLiftBuf 将 mc 复制到以 pad 填充的 C 缓冲区，调用方必须持有 liftMu
+func prepareLiftBuf(mc []byte, pad byte) unsafe.Pointer {
+	need := len(mc) + liftPadding
+	if need > liftBufCap {
+		C.free(liftBuf)
+		liftBuf = C.malloc(C.size_t(need))
+		liftBufCap = need
+	}
+	buf := unsafe.Slice((*byte)(liftBuf), need)
+	n := copy(buf, mc)
+	for i := n; i < need; i++ {
+		buf[i] = pad
+	}
+	return liftBuf
+}
+
+// vexLift 执行实际的提升，调用方必须持有 liftMu
+func vexLift(v VexArch, mc []byte, insAddr uint64, en VexEndness, opts *LiftOptions) (*LiftResult, error) {
+	if opts == nil {
+		opts = DefaultLiftOptions()
+	}
+	if !isSupportedArch(v) {
+		return nil, &LiftError{Err: ErrUnsupportedArch, Arch: v, Addr: insAddr}
+	}
+	if len(mc) == 0 {
+		return nil, &LiftError{Err: ErrEmptyInput, Arch: v, Addr: insAddr}
+	}
+	if err := opts.check(len(mc)); err != nil {
+		return nil, &LiftError{Err: err, Arch: v, Addr: insAddr}
+	}
+	// 未初始化的 VEX 没有日志回调，vex_lift 会在 C 代码中崩溃
+	if err := vexInit(); err != nil {
+		return nil, &LiftError{Err: err, Arch: v, Addr: insAddr}
+	}
+	lookback := int(opts.LookbackAmount)
+	maxBytes := opts.MaxBytes
+	if maxBytes > uint32(len(mc)-lookback) {
+		maxBytes = uint32(len(mc) - lookback)
+	}
+
+	ai := opts.ArchInfo
+	if ai == nil {
+		ai = DefaultArchInfo(v)
+	}
+	vai := ai.toC(en)
+
+	lift := func(pad byte) *C.VEXLiftResult {
+		cData := (*C.uchar)(unsafe.Add(prepareLiftBuf(mc, pad), lookback))
+		return C.vex_lift(C.VexArch(v), vai, cData, C.ulonglong(insAddr), C.uint(opts.MaxInsns), C.uint(maxBytes),
+			C.int(opts.OptLevel), C.int(opts.TraceFlags), cBool(opts.AllowArchOptimizations), cBool(opts.StrictBlockEnd),
+			cBool(opts.CollectDataRefs), cBool(opts.LoadFromRORegions), cBool(opts.ConstProp),
+			C.VexRegisterUpdates(opts.RegisterUpdates), C.uint(opts.LookbackAmount))
+	}
+	r := lift(0)
 	if r == nil {
-		return nil
+		log := vexLog()
+		return nil, &LiftError{Err: classifyVexLog(log), Arch: v, Addr: insAddr, Log: log}
+	}
+	res := newLiftResult(r)
+	// 第一条指令就无法解码时 VEX 仍会生成一个 Ijk_NoDecode 的空块
+	if res.IRSB.JumpKind == IjkNoDecode && res.Size == 0 {
+		log := vexLog()
+		cause := ErrDecode
+		if int(maxBytes) < insnLenHint(v, mc[lookback:], insAddr, en) {
+			cause = ErrTruncated
+		} else if (v == VexArchX86 || v == VexArchAMD64) && len(mc)-lookback < x86MaxInsnLen {
+			// 变长指令无法预先推算长度：指令若完整，解码结果与输入之后的字节无关，
+			// 换一种填充后结果发生变化说明解码器读到了输入末尾之外。此时指令通常超出
+			// guest_max_bytes，VEX 会以 "Not enough bytes" 失败
+			for _, pad := range x86ProbePadding {
+				r := lift(pad)
+				if r == nil && classifyVexLog(vexLog()) == ErrTruncated || r != nil && (r.irsb.jumpkind != C.Ijk_NoDecode || r.size != 0) {
+					cause = ErrTruncated
+					break
+				}
+			}
+		}
+		return nil, &LiftError{Err: cause, Arch: v, Addr: insAddr, Log: log}
 	}
-	return (*IRSb)(unsafe.Pointer(r.irsb))
+	return res, nil
 }
@@ -1,14 +1,21 @@
 package vex_go
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"os/exec"
 	"testing"
 )
 
 func TestVexInit(t *testing.T) {
 	VexInit()
 	mc := []byte{0xe2, 0x03, 0x00, 0xaa}
-	liftR := VexLift(VexArchARM64, mc, 0x1000, VexEndnessLE)
+	r, err := VexLift(VexArchARM64, mc, 0x1000, VexEndnessLE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	liftR := r.IRSB
 	fmt.Println(liftR.TyEnv)
 	for i := 0; i < int(liftR.StmtsUsed); i++ {
 		stmt := liftR.GetStmt(i)
@@ -33,3 +40,201 @@ func TestVexInit(t *testing.T) {
 		}
 	}
 }
+
+func TestVexLiftOptions(t *testing.T) {
+	VexInit()
+	// mov x2, x0; mov x3, x1; ret
+	mc := []byte{0xe2, 0x03, 0x00, 0xaa, 0xe3, 0x03, 0x01, 0xaa, 0xc0, 0x03, 0x5f, 0xd6}
+
+	r, err := VexLift(VexArchARM64, mc, 0x1000, VexEndnessLE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Insts() != 3 || r.Size != 12 {
+		t.Fatalf("expected 3 instructions in 12 bytes, got %d in %d", r.Insts(), r.Size)
+	}
+
+	opts := DefaultLiftOptions()
+	opts.MaxInsns = 1
+	r, err = VexLift(VexArchARM64, mc, 0x1000, VexEndnessLE, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Insts() != 1 || r.Size != 4 {
+		t.Fatalf("expected 1 instruction in 4 bytes, got %d in %d", r.Insts(), r.Size)
+	}
+}
+
+func TestLiftResult(t *testing.T) {
+	VexInit()
+	// cbz x0, 0x1008
+	mc := []byte{0x40, 0x00, 0x00, 0xb4}
+	r, err := VexLift(VexArchARM64, mc, 0x1000, VexEndnessLE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.InstAddrs) != 1 || r.InstAddrs[0] != 0x1000 {
+		t.Fatalf("unexpected instruction addresses %#x", r.InstAddrs)
+	}
+	if len(r.Exits) != 1 || r.Exits[0].InsAddr != 0x1000 {
+		t.Fatalf("unexpected exits %+v", r.Exits)
+	}
+	exit := r.IRSB.GetStmt(r.Exits[0].StmtIdx)
+	if exit.Tag != IstExit || exit != r.Exits[0].Stmt {
+		t.Fatalf("exit statement mismatch")
+	}
+	if dst := exit.AsExit().Dst; dst.U64() != 0x1008 || dst.AsUint64() != 0x1008 || dst.Type() != ItyI64 {
+		t.Fatalf("unexpected exit target %#x", dst.AsUint64())
+	}
+	if !r.IsDefaultExitConstant || r.DefaultExit != 0x1004 {
+		t.Fatalf("unexpected default exit %v %#x", r.IsDefaultExitConstant, r.DefaultExit)
+	}
+}
+
+// 未调用 VexInit 时提升应自动初始化。初始化是进程级的，因此在只运行本测试的子进程中检查
+func TestLiftWithoutInit(t *testing.T) {
+	if os.Getenv("VEX_GO_LIFT_WITHOUT_INIT") == "1" {
+		if _, err := VexLift(VexArchARM64, []byte{0xc0, 0x03, 0x5f, 0xd6}, 0x1000, VexEndnessLE, nil); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	cmd := exec.Command(os.Args[0], "-test.run=^TestLiftWithoutInit$")
+	cmd.Env = append(os.Environ(), "VEX_GO_LIFT_WITHOUT_INIT=1")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+}
+
+func TestLiftErrors(t *testing.T) {
+	VexInit()
+	cases := []struct {
+		name string
+		arch VexArch
+		mc   []byte
+		want error
+	}{
+		{"empty", VexArchARM64, nil, ErrEmptyInput},
+		{"unsupported", VexArchTILEGX, []byte{0, 0, 0, 0}, ErrUnsupportedArch},
+		{"undefined", VexArchARM64, []byte{0x00, 0x00, 0x00, 0x00}, ErrDecode},
+	}
+	for _, c := range cases {
+		_, err := VexLift(c.arch, c.mc, 0x1000, VexEndnessLE, nil)
+		if !errors.Is(err, c.want) {
+			t.Errorf("%s: got %v, want %v", c.name, err, c.want)
+		}
+		var le *LiftError
+		if !errors.As(err, &le) || le.Addr != 0x1000 {
+			t.Errorf("%s: expected *LiftError, got %T", c.name, err)
+		}
+	}
+
+	// 超出 VEX 范围的参数在调用 VEX 之前被拒绝
+	ret := []byte{0xc0, 0x03, 0x5f, 0xd6}
+	for _, c := range []struct {
+		name string
+		set  func(o *LiftOptions)
+		ok   bool
+	}{
+		{"MaxInsns 0", func(o *LiftOptions) { o.MaxInsns = 0 }, false},
+		{"MaxInsns 1", func(o *LiftOptions) { o.MaxInsns = 1 }, true},
+		{"MaxInsns 99", func(o *LiftOptions) { o.MaxInsns = MaxLiftInsns }, true},
+		{"MaxInsns 100", func(o *LiftOptions) { o.MaxInsns = MaxLiftInsns + 1 }, false},
+		{"MaxBytes 0", func(o *LiftOptions) { o.MaxBytes = 0 }, false},
+		{"MaxBytes 4", func(o *LiftOptions) { o.MaxBytes = 4 }, true},
+		{"MaxBytes 5000", func(o *LiftOptions) { o.MaxBytes = MaxLiftBytes }, true},
+		{"MaxBytes 5001", func(o *LiftOptions) { o.MaxBytes = MaxLiftBytes + 1 }, false},
+		{"OptLevel -1", func(o *LiftOptions) { o.OptLevel = -1 }, false},
+		{"OptLevel 0", func(o *LiftOptions) { o.OptLevel = 0 }, true},
+		{"OptLevel 2", func(o *LiftOptions) { o.OptLevel = 2 }, true},
+		{"OptLevel 3", func(o *LiftOptions) { o.OptLevel = 3 }, false},
+		{"RegisterUpdates invalid", func(o *LiftOptions) { o.RegisterUpdates = VexRegUpdInvalid }, false},
+		{"RegisterUpdates sp", func(o *LiftOptions) { o.RegisterUpdates = VexRegUpdSpAtMemAccess }, true},
+		{"RegisterUpdates ld", func(o *LiftOptions) { o.RegisterUpdates = VexRegUpdLdAllregsAtEachInsn }, true},
+		{"RegisterUpdates past end", func(o *LiftOptions) { o.RegisterUpdates = VexRegUpdLdAllregsAtEachInsn + 1 }, false},
+		{"zero value", func(o *LiftOptions) { *o = LiftOptions{} }, false},
+	} {
+		opts := DefaultLiftOptions()
+		c.set(opts)
+		_, err := VexLift(VexArchARM64, ret, 0x1000, VexEndnessLE, opts)
+		switch {
+		case c.ok && err != nil:
+			t.Errorf("%s: %v", c.name, err)
+		case !c.ok && !errors.Is(err, ErrInvalidOptions):
+			t.Errorf("%s: got %v, want %v", c.name, err, ErrInvalidOptions)
+		}
+	}
+}
+
+func TestLiftShortInput(t *testing.T) {
+	VexInit()
+	truncated := []struct {
+		name string
+		arch VexArch
+		en   VexEndness
+		mc   []byte
+	}{
+		{"arm64", VexArchARM64, VexEndnessLE, []byte{0xe2, 0x03}},
+		{"amd64", VexArchAMD64, VexEndnessLE, []byte{0x48, 0x83, 0xc0}},
+		{"x86", VexArchX86, VexEndnessLE, []byte{0x05, 0x01}},
+		{"s390x", VexArchS390X, VexEndnessBE, []byte{0xe3, 0x10, 0xf0, 0x00, 0x00}},
+		// 以下输入补零后恰好是无法解码的指令
+		{"amd64 0f", VexArchAMD64, VexEndnessLE, []byte{0x0f}},
+		{"amd64 vex3", VexArchAMD64, VexEndnessLE, []byte{0xc4}},
+		{"amd64 vex3 0f38", VexArchAMD64, VexEndnessLE, []byte{0xc4, 0xe2}},
+		{"amd64 66 0f", VexArchAMD64, VexEndnessLE, []byte{0x66, 0x0f}},
+		{"x86 0f", VexArchX86, VexEndnessLE, []byte{0x0f}},
+	}
+	for _, c := range truncated {
+		_, err := VexLift(c.arch, c.mc, 0x1000, c.en, nil)
+		if !errors.Is(err, ErrTruncated) {
+			t.Errorf("%s: got %v, want %v", c.name, err, ErrTruncated)
+		}
+	}
+	// 完整的非法指令不受输入之后的字节影响
+	for _, mc := range [][]byte{{0x0f, 0x04}, {0xc4, 0xe2, 0x79, 0xff, 0xc0}} {
+		if _, err := VexLift(VexArchAMD64, mc, 0x1000, VexEndnessLE, nil); !errors.Is(err, ErrDecode) {
+			t.Errorf("%x: got %v, want %v", mc, err, ErrDecode)
+		}
+	}
+
+	// 块在最后一条完整的指令处结束
+	r, err := VexLift(VexArchAMD64, []byte{0x90, 0x48, 0x83, 0xc0}, 0x1000, VexEndnessLE, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Size != 1 || r.Insts() != 1 {
+		t.Fatalf("expected a single 1-byte instruction, got %d bytes/%d insts", r.Size, r.Insts())
+	}
+
+	// 回看字节位于输入开头
+	opts := DefaultLiftOptions()
+	opts.LookbackAmount = 4
+	r, err = VexLift(VexArchARM64, []byte{0x1f, 0x20, 0x03, 0xd5, 0xc0, 0x03, 0x5f, 0xd6}, 0x1004, VexEndnessLE, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Size != 4 || r.InstAddrs[0] != 0x1004 {
+		t.Fatalf("unexpected block %d bytes at %#x", r.Size, r.InstAddrs)
+	}
+	if _, err = VexLift(VexArchARM64, []byte{0x1f, 0x20, 0x03, 0xd5}, 0x1004, VexEndnessLE, opts); !errors.Is(err, ErrInvalidOptions) {
+		t.Fatalf("got %v, want %v", err, ErrInvalidOptions)
+	}
+}
+
+func TestConstantAccessors(t *testing.T) {
+	c := Constant{Tag: IcoF64, Bits: 0x3ff0000000000000}
+	if c.F64() != 1.0 || c.AsUint64() != 0x3ff0000000000000 {
+		t.Fatalf("unexpected F64 value %v", c.F64())
+	}
+	v := Constant{Tag: IcoV128, Bits: 0x8001}
+	if got := v.AsBigInt().Text(16); got != "ff0000000000000000000000000000ff" {
+		t.Fatalf("unexpected V128 expansion %s", got)
+	}
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic on tag mismatch")
+		}
+	}()
+	c.U64()
+}
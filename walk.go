@@ -0,0 +1,302 @@
+package vex_go
+
+import "slices"
+
+// 本文件提供类似 go/ast 的遍历接口，以及在不修改原块的前提下替换表达式与语句的 Rewrite
+
+// Node 是遍历中的节点：*Block、Stmt 或 Expr
+type Node interface {
+	String() string
+}
+
+// Visitor 的 Visit 方法对遍历到的每个节点调用。返回的 w 不为 nil 时，
+// Walk 用 w 访问该节点的子节点，随后调用 w.Visit(nil)
+type Visitor interface {
+	Visit(node Node) (w Visitor)
+}
+
+// Walk 以深度优先顺序遍历 node 及其所有子节点。子节点按打印顺序访问：
+// 块依次为各条语句与 Next，语句与表达式为其中的各个子表达式，nil 子表达式会被跳过
+func Walk(v Visitor, node Node) {
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	walkExpr := func(e Expr) {
+		if e != nil {
+			Walk(v, e)
+		}
+	}
+	switch n := node.(type) {
+	case *Block:
+		for _, s := range n.Stmts {
+			if s != nil {
+				Walk(v, s)
+			}
+		}
+		walkExpr(n.Next)
+
+	// 语句
+	case *NoOpStmt, *IMarkStmt, *MBEStmt:
+	case *AbiHintStmt:
+		walkExpr(n.Base)
+		walkExpr(n.Nia)
+	case *PutStmt:
+		walkExpr(n.Data)
+	case *PutIStmt:
+		walkExpr(n.Ix)
+		walkExpr(n.Data)
+	case *WrTmpStmt:
+		walkExpr(n.Data)
+	case *StoreStmt:
+		walkExpr(n.Addr)
+		walkExpr(n.Data)
+	case *StoreGStmt:
+		walkExpr(n.Guard)
+		walkExpr(n.Addr)
+		walkExpr(n.Data)
+	case *LoadGStmt:
+		walkExpr(n.Guard)
+		walkExpr(n.Addr)
+		walkExpr(n.Alt)
+	case *CASStmt:
+		walkExpr(n.Addr)
+		walkExpr(n.ExpdHi)
+		walkExpr(n.ExpdLo)
+		walkExpr(n.DataHi)
+		walkExpr(n.DataLo)
+	case *LLSCStmt:
+		walkExpr(n.Addr)
+		walkExpr(n.StoreData)
+	case *DirtyStmt:
+		walkExpr(n.Guard)
+		walkExpr(n.MAddr)
+		for _, a := range n.Args {
+			walkExpr(a)
+		}
+	case *ExitStmt:
+		walkExpr(n.Guard)
+
+	// 表达式
+	case *BinderExpr, *GetExpr, *RdTmpExpr, *ConstExpr, *VECRETExpr, *GSPTRExpr:
+	case *GetIExpr:
+		walkExpr(n.Ix)
+	case *QopExpr:
+		walkExpr(n.Arg1)
+		walkExpr(n.Arg2)
+		walkExpr(n.Arg3)
+		walkExpr(n.Arg4)
+	case *TriopExpr:
+		walkExpr(n.Arg1)
+		walkExpr(n.Arg2)
+		walkExpr(n.Arg3)
+	case *BinopExpr:
+		walkExpr(n.Arg1)
+		walkExpr(n.Arg2)
+	case *UnopExpr:
+		walkExpr(n.Arg)
+	case *LoadExpr:
+		walkExpr(n.Addr)
+	case *ITEExpr:
+		walkExpr(n.Cond)
+		walkExpr(n.IfTrue)
+		walkExpr(n.IfFalse)
+	case *CCallExpr:
+		for _, a := range n.Args {
+			walkExpr(a)
+		}
+	default:
+		panic("vex: unexpected node type in Walk")
+	}
+	v.Visit(nil)
+}
+
+type inspector func(Node) bool
+
+func (f inspector) Visit(node Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect 以深度优先顺序遍历 node，对每个节点调用 f(node)。f 返回 true 时继续访问该节点的子节点，
+// 访问完子节点后调用 f(nil)
+func Inspect(node Node, f func(Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// Rewrite 返回 b 的一个副本，其中的表达式与语句经过 exprFn 与 stmtFn 替换，b 本身不会被修改。
+//
+// exprFn 按后序对每个表达式调用，调用时子表达式已经替换完毕，返回值替代该表达式，返回 nil 表示保留。
+// stmtFn 在语句中的表达式替换完毕后调用，返回值替代该语句：返回空切片删除该语句，返回多条语句可以插入
+// 新的语句。两个函数都可以为 nil。传给回调的节点都是新分配的副本，可以直接修改。
+// Next 表达式同样经过 exprFn 替换
+func Rewrite(b *Block, exprFn func(Expr) Expr, stmtFn func(Stmt) []Stmt) *Block {
+	r := &rewriter{exprFn: exprFn}
+	nb := &Block{
+		Arch:     b.Arch,
+		TypeEnv:  slices.Clone(b.TypeEnv),
+		JumpKind: b.JumpKind,
+		OffsIP:   b.OffsIP,
+	}
+	if b.Stmts != nil {
+		nb.Stmts = make([]Stmt, 0, len(b.Stmts))
+	}
+	for _, s := range b.Stmts {
+		s = r.stmt(s)
+		if stmtFn == nil {
+			nb.Stmts = append(nb.Stmts, s)
+			continue
+		}
+		nb.Stmts = append(nb.Stmts, stmtFn(s)...)
+	}
+	nb.Next = r.expr(b.Next)
+	return nb
+}
+
+type rewriter struct {
+	exprFn func(Expr) Expr
+}
+
+// exprs 复制并替换一组表达式
+func (r *rewriter) exprs(args []Expr) []Expr {
+	if args == nil {
+		return nil
+	}
+	out := make([]Expr, len(args))
+	for i, a := range args {
+		out[i] = r.expr(a)
+	}
+	return out
+}
+
+// expr 复制 e 及其子表达式，并按后序调用 exprFn
+func (r *rewriter) expr(e Expr) Expr {
+	var n Expr
+	switch e := e.(type) {
+	case nil:
+		return nil
+	case *BinderExpr:
+		c := *e
+		n = &c
+	case *GetExpr:
+		c := *e
+		n = &c
+	case *GetIExpr:
+		c := *e
+		c.Ix = r.expr(e.Ix)
+		n = &c
+	case *RdTmpExpr:
+		c := *e
+		n = &c
+	case *QopExpr:
+		c := *e
+		c.Arg1, c.Arg2, c.Arg3, c.Arg4 = r.expr(e.Arg1), r.expr(e.Arg2), r.expr(e.Arg3), r.expr(e.Arg4)
+		n = &c
+	case *TriopExpr:
+		c := *e
+		c.Arg1, c.Arg2, c.Arg3 = r.expr(e.Arg1), r.expr(e.Arg2), r.expr(e.Arg3)
+		n = &c
+	case *BinopExpr:
+		c := *e
+		c.Arg1, c.Arg2 = r.expr(e.Arg1), r.expr(e.Arg2)
+		n = &c
+	case *UnopExpr:
+		c := *e
+		c.Arg = r.expr(e.Arg)
+		n = &c
+	case *LoadExpr:
+		c := *e
+		c.Addr = r.expr(e.Addr)
+		n = &c
+	case *ConstExpr:
+		c := *e
+		n = &c
+	case *ITEExpr:
+		c := *e
+		c.Cond, c.IfTrue, c.IfFalse = r.expr(e.Cond), r.expr(e.IfTrue), r.expr(e.IfFalse)
+		n = &c
+	case *CCallExpr:
+		c := *e
+		c.Args = r.exprs(e.Args)
+		n = &c
+	case *VECRETExpr:
+		n = &VECRETExpr{}
+	case *GSPTRExpr:
+		n = &GSPTRExpr{}
+	default:
+		panic("vex: unexpected expression type in Rewrite")
+	}
+	if r.exprFn != nil {
+		if x := r.exprFn(n); x != nil {
+			return x
+		}
+	}
+	return n
+}
+
+// stmt 复制 s 并替换其中的表达式
+func (r *rewriter) stmt(s Stmt) Stmt {
+	switch s := s.(type) {
+	case nil:
+		return nil
+	case *NoOpStmt:
+		return &NoOpStmt{}
+	case *IMarkStmt:
+		c := *s
+		return &c
+	case *AbiHintStmt:
+		c := *s
+		c.Base, c.Nia = r.expr(s.Base), r.expr(s.Nia)
+		return &c
+	case *PutStmt:
+		c := *s
+		c.Data = r.expr(s.Data)
+		return &c
+	case *PutIStmt:
+		c := *s
+		c.Ix, c.Data = r.expr(s.Ix), r.expr(s.Data)
+		return &c
+	case *WrTmpStmt:
+		c := *s
+		c.Data = r.expr(s.Data)
+		return &c
+	case *StoreStmt:
+		c := *s
+		c.Addr, c.Data = r.expr(s.Addr), r.expr(s.Data)
+		return &c
+	case *StoreGStmt:
+		c := *s
+		c.Guard, c.Addr, c.Data = r.expr(s.Guard), r.expr(s.Addr), r.expr(s.Data)
+		return &c
+	case *LoadGStmt:
+		c := *s
+		c.Guard, c.Addr, c.Alt = r.expr(s.Guard), r.expr(s.Addr), r.expr(s.Alt)
+		return &c
+	case *CASStmt:
+		c := *s
+		c.Addr = r.expr(s.Addr)
+		c.ExpdHi, c.ExpdLo = r.expr(s.ExpdHi), r.expr(s.ExpdLo)
+		c.DataHi, c.DataLo = r.expr(s.DataHi), r.expr(s.DataLo)
+		return &c
+	case *LLSCStmt:
+		c := *s
+		c.Addr, c.StoreData = r.expr(s.Addr), r.expr(s.StoreData)
+		return &c
+	case *DirtyStmt:
+		c := *s
+		c.Guard, c.MAddr = r.expr(s.Guard), r.expr(s.MAddr)
+		c.Args = r.exprs(s.Args)
+		c.FxState = slices.Clone(s.FxState)
+		return &c
+	case *MBEStmt:
+		c := *s
+		return &c
+	case *ExitStmt:
+		c := *s
+		c.Guard = r.expr(s.Guard)
+		return &c
+	default:
+		panic("vex: unexpected statement type in Rewrite")
+	}
+}
@@ -0,0 +1,103 @@
+package vex_go
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestInspect(t *testing.T) {
+	seen := map[string]int{}
+	for _, b := range liftTestBlocks(t) {
+		depth, maxDepth := 0, 0
+		Inspect(b, func(n Node) bool {
+			if n == nil {
+				depth--
+				return false
+			}
+			depth++
+			maxDepth = max(maxDepth, depth)
+			seen[fmt.Sprintf("%T", n)]++
+			return true
+		})
+		if depth != 0 || maxDepth < 3 {
+			t.Errorf("%v: unbalanced walk, depth %d max %d", b.Arch, depth, maxDepth)
+		}
+	}
+	// CAS 操作数、CCall 参数与 Dirty 参数都应被访问到
+	for _, typ := range []string{"*vex_go.CASStmt", "*vex_go.CCallExpr", "*vex_go.DirtyStmt", "*vex_go.GSPTRExpr",
+		"*vex_go.LLSCStmt", "*vex_go.LoadGStmt", "*vex_go.StoreGStmt", "*vex_go.ITEExpr", "*vex_go.TriopExpr"} {
+		if seen[typ] == 0 {
+			t.Errorf("%s never visited: %v", typ, seen)
+		}
+	}
+
+	// 返回 false 时不进入子节点
+	b := liftTestBlocks(t)[0]
+	var stmts int
+	Inspect(b, func(n Node) bool {
+		if _, ok := n.(Stmt); ok {
+			stmts++
+		}
+		_, isBlock := n.(*Block)
+		return isBlock
+	})
+	if stmts != len(b.Stmts) {
+		t.Errorf("visited %d statements, want %d", stmts, len(b.Stmts))
+	}
+}
+
+func TestRewrite(t *testing.T) {
+	for _, b := range liftTestBlocks(t) {
+		if nb := Rewrite(b, nil, nil); !reflect.DeepEqual(nb, b) {
+			t.Errorf("%v: identity rewrite differs", b.Arch)
+		}
+	}
+
+	b := NewIRSBBuilder(VexArchAMD64)
+	b.IMark(0x1000, 4)
+	t0 := b.Assign(b.Binop(IopAdd64, b.GetReg("rdi"), b.U64(8)))
+	b.Store(b.RdTmp(t0), b.GetReg("rsi"))
+	b.SetNext(b.GetReg("rdi"), IjkRet)
+	blk, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := blk.String()
+	rdi, _ := RegisterOffset(VexArchAMD64, "rdi")
+	nb := Rewrite(blk, func(e Expr) Expr {
+		// 把 rdi 替换为常量
+		if g, ok := e.(*GetExpr); ok && g.Offset == rdi {
+			return &ConstExpr{Con: Constant{Tag: IcoU64, Bits: 0x5000}}
+		}
+		// 回调收到的是副本，可以直接修改
+		if c, ok := e.(*ConstExpr); ok && c.Con.Bits == 8 {
+			c.Con.Bits = 16
+		}
+		return nil
+	}, func(s Stmt) []Stmt {
+		switch s.(type) {
+		case *IMarkStmt:
+			return nil
+		case *StoreStmt:
+			return []Stmt{&MBEStmt{Event: ImbeFence}, s}
+		}
+		return []Stmt{s}
+	})
+	got := nb.String()
+	for _, want := range []string{
+		"   t0 = Add64(0x5000:I64,0x10:I64)\n   IR-Fence\n   STle(t0) = GET:I64(64)\n",
+		"PUT(184) = 0x5000:I64; exit-Return",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("rewritten block missing %q:\n%s", want, got)
+		}
+	}
+	if strings.Contains(got, "IMark") {
+		t.Errorf("IMark not removed:\n%s", got)
+	}
+	if blk.String() != orig {
+		t.Errorf("original block modified:\n%s", blk)
+	}
+}